@@ -0,0 +1,12 @@
+resource "iotcentral_device" "example" {
+  id           = "thermostat-001"
+  display_name = "Thermostat 001"
+}
+
+data "iotcentral_device_credentials" "example" {
+  device_id = iotcentral_device.example.id
+}
+
+output "id_scope" {
+  value = data.iotcentral_device_credentials.example.id_scope
+}
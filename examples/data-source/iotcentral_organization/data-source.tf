@@ -0,0 +1,9 @@
+data "iotcentral_organization" "factories" {
+  display_name = "Factories"
+}
+
+resource "iotcentral_organization" "example" {
+  id           = "factory-berlin"
+  display_name = "Berlin"
+  parent       = data.iotcentral_organization.factories.id
+}
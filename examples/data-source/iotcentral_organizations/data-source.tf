@@ -0,0 +1,18 @@
+data "iotcentral_organizations" "factories" {
+  root = "factories"
+}
+
+data "iotcentral_role" "operator" {
+  display_name = "Org Operator"
+}
+
+resource "iotcentral_user" "operator" {
+  email = "operator@example.com"
+
+  roles = [
+    for organization in data.iotcentral_organizations.factories.organizations : {
+      role         = data.iotcentral_role.operator.id
+      organization = organization.id
+    }
+  ]
+}
@@ -0,0 +1,10 @@
+data "iotcentral_roles" "all" {}
+
+resource "iotcentral_user" "example" {
+  email = "operator@example.com"
+  roles = [
+    {
+      role = data.iotcentral_roles.all.by_name["Operator"]
+    }
+  ]
+}
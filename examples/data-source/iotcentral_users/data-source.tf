@@ -0,0 +1,9 @@
+data "iotcentral_roles" "all" {}
+
+data "iotcentral_users" "administrators" {
+  role = data.iotcentral_roles.all.by_name["Administrator"]
+}
+
+output "administrators" {
+  value = [for user in data.iotcentral_users.administrators.users : coalesce(user.email, user.object_id)]
+}
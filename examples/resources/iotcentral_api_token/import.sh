@@ -0,0 +1 @@
+terraform import iotcentral_api_token.example automation
\ No newline at end of file
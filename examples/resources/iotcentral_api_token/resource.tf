@@ -0,0 +1,20 @@
+data "iotcentral_role" "example" {
+  display_name = "Operator"
+}
+
+resource "time_rotating" "example" {
+  rotation_days = 90
+}
+
+resource "iotcentral_api_token" "example" {
+  id = "automation"
+  roles = [
+    {
+      role = data.iotcentral_role.example.id
+    }
+  ]
+  expiry = timeadd(time_rotating.example.id, "2160h")
+  rotate_when_changed = {
+    rotation = time_rotating.example.id
+  }
+}
@@ -0,0 +1 @@
+terraform import iotcentral_data_export.example thermostat-telemetry
\ No newline at end of file
@@ -0,0 +1,36 @@
+variable "event_hub_connection_string" {
+  type      = string
+  sensitive = true
+}
+
+resource "iotcentral_destination" "example" {
+  id           = "telemetry-hub"
+  display_name = "Telemetry event hub"
+
+  event_hubs {
+    connection_string = var.event_hub_connection_string
+  }
+}
+
+resource "iotcentral_data_export" "example" {
+  id           = "thermostat-telemetry"
+  display_name = "Thermostat telemetry"
+  source       = "telemetry"
+  filter       = "SELECT * FROM dtmi:example:thermostat;1 WHERE temperature > 30"
+
+  enrichments = {
+    environment = {
+      value = "production"
+    }
+    device_name = {
+      path = "$displayName"
+    }
+  }
+
+  destinations = [
+    {
+      id        = iotcentral_destination.example.id
+      transform = "{ device: .device.id, temperature: .telemetry[0].value }"
+    }
+  ]
+}
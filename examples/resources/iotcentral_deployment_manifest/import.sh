@@ -0,0 +1 @@
+terraform import iotcentral_deployment_manifest.example gateway
\ No newline at end of file
@@ -0,0 +1,5 @@
+resource "iotcentral_deployment_manifest" "example" {
+  id           = "gateway"
+  display_name = "Gateway modules"
+  data         = file("${path.module}/manifests/gateway.json")
+}
@@ -0,0 +1 @@
+terraform import iotcentral_destination.example telemetry-hub
\ No newline at end of file
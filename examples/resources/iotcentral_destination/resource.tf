@@ -0,0 +1,41 @@
+variable "event_hub_connection_string" {
+  type      = string
+  sensitive = true
+}
+
+variable "webhook_api_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "iotcentral_destination" "event_hubs" {
+  id           = "telemetry-hub"
+  display_name = "Telemetry event hub"
+
+  event_hubs {
+    connection_string = var.event_hub_connection_string
+  }
+}
+
+resource "iotcentral_destination" "data_explorer" {
+  id           = "telemetry-adx"
+  display_name = "Telemetry Data Explorer table"
+
+  data_explorer {
+    cluster_url = "https://example.westeurope.kusto.windows.net"
+    database    = "iotcentral"
+    table       = "telemetry"
+  }
+}
+
+resource "iotcentral_destination" "webhook" {
+  id           = "alerts-webhook"
+  display_name = "Alerts webhook"
+
+  webhook {
+    url = "https://example.com/alerts"
+    headers = {
+      "X-Api-Key" = var.webhook_api_key
+    }
+  }
+}
@@ -0,0 +1 @@
+terraform import iotcentral_device.example thermostat-001
\ No newline at end of file
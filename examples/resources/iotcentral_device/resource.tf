@@ -0,0 +1,22 @@
+resource "iotcentral_organization" "example" {
+  id           = "example"
+  display_name = "Example"
+}
+
+resource "iotcentral_device_template" "example" {
+  id           = "dtmi:example:thermostat;1"
+  display_name = "Thermostat"
+  capability_model = jsonencode({
+    "@id"         = "dtmi:example:thermostat:model;1"
+    "@type"       = "Interface"
+    "displayName" = "Thermostat"
+    "contents"    = []
+  })
+}
+
+resource "iotcentral_device" "example" {
+  id            = "thermostat-001"
+  display_name  = "Thermostat 001"
+  template      = iotcentral_device_template.example.id
+  organizations = [iotcentral_organization.example.id]
+}
@@ -0,0 +1 @@
+terraform import iotcentral_device_deployment_manifest_assignment.example gateway-001
\ No newline at end of file
@@ -0,0 +1,11 @@
+resource "iotcentral_deployment_manifest" "example" {
+  id           = "gateway"
+  display_name = "Gateway modules"
+  data         = file("${path.module}/manifests/gateway.json")
+}
+
+resource "iotcentral_device_deployment_manifest_assignment" "example" {
+  device_id                = "gateway-001"
+  deployment_manifest_id   = iotcentral_deployment_manifest.example.id
+  deployment_manifest_etag = iotcentral_deployment_manifest.example.etag
+}
@@ -0,0 +1 @@
+terraform import iotcentral_device_group.example thermostats
\ No newline at end of file
@@ -0,0 +1,12 @@
+resource "iotcentral_organization" "example" {
+  id           = "example"
+  display_name = "Example"
+}
+
+resource "iotcentral_device_group" "example" {
+  id            = "thermostats"
+  display_name  = "Thermostats"
+  description   = "All thermostats of the example organization"
+  filter        = "SELECT * FROM devices WHERE $template = \"dtmi:example:thermostat;1\""
+  organizations = [iotcentral_organization.example.id]
+}
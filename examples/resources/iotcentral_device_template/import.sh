@@ -0,0 +1 @@
+terraform import iotcentral_device_template.example "dtmi:example:thermostat;1"
\ No newline at end of file
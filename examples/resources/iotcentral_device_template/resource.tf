@@ -0,0 +1,16 @@
+resource "iotcentral_device_template" "example" {
+  id           = "dtmi:example:thermostat;1"
+  display_name = "Thermostat"
+  capability_model = jsonencode({
+    "@id"         = "dtmi:example:thermostat:model;1"
+    "@type"       = "Interface"
+    "displayName" = "Thermostat"
+    "contents" = [
+      {
+        "@type"  = "Telemetry"
+        "name"   = "temperature"
+        "schema" = "double"
+      }
+    ]
+  })
+}
@@ -0,0 +1 @@
+terraform import iotcentral_enrollment_group.example factory
\ No newline at end of file
@@ -0,0 +1,5 @@
+resource "iotcentral_enrollment_group" "example" {
+  id               = "factory"
+  display_name     = "Factory devices"
+  attestation_type = "symmetricKey"
+}
@@ -0,0 +1 @@
+terraform import iotcentral_enrollment_group_certificate.example factory/primary
\ No newline at end of file
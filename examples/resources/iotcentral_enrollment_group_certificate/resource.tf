@@ -0,0 +1,12 @@
+resource "iotcentral_enrollment_group" "example" {
+  id               = "factory"
+  display_name     = "Factory devices"
+  attestation_type = "x509"
+}
+
+resource "iotcentral_enrollment_group_certificate" "example" {
+  enrollment_group_id = iotcentral_enrollment_group.example.id
+  entry               = "primary"
+  certificate         = file("ca.pem")
+  private_key         = file("ca.key")
+}
@@ -0,0 +1 @@
+terraform import iotcentral_file_upload.example fileUpload
\ No newline at end of file
@@ -0,0 +1,10 @@
+variable "storage_connection_string" {
+  type      = string
+  sensitive = true
+}
+
+resource "iotcentral_file_upload" "example" {
+  connection_string = var.storage_connection_string
+  container         = "fileuploads"
+  sas_ttl           = "PT2H"
+}
@@ -0,0 +1 @@
+terraform import iotcentral_job.example set-target-temperature
\ No newline at end of file
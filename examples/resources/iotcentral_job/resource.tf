@@ -0,0 +1,31 @@
+resource "iotcentral_device_group" "thermostats" {
+  id           = "thermostats"
+  display_name = "Thermostats"
+  filter       = "SELECT * FROM devices WHERE $template = \"dtmi:example:thermostat;1\""
+}
+
+resource "iotcentral_job" "example" {
+  id                  = "set-target-temperature"
+  display_name        = "Set target temperature"
+  group               = iotcentral_device_group.thermostats.id
+  wait_for_completion = true
+
+  batch = {
+    type  = "percentage"
+    value = 25
+  }
+
+  cancellation_threshold = {
+    type  = "percentage"
+    value = 10
+  }
+
+  data = [
+    {
+      type   = "property"
+      target = "dtmi:example:thermostat;1"
+      path   = "targetTemperature"
+      value  = jsonencode(21.5)
+    }
+  ]
+}
@@ -0,0 +1 @@
+terraform import iotcentral_scheduled_job.example nightly-config-sync
\ No newline at end of file
@@ -0,0 +1,31 @@
+resource "iotcentral_device_group" "thermostats" {
+  id           = "thermostats"
+  display_name = "Thermostats"
+  filter       = "SELECT * FROM devices WHERE $template = \"dtmi:example:thermostat;1\""
+}
+
+resource "iotcentral_scheduled_job" "example" {
+  id           = "nightly-config-sync"
+  display_name = "Nightly configuration sync"
+  group        = iotcentral_device_group.thermostats.id
+
+  schedule = {
+    start      = "2024-01-01T02:00:00Z"
+    recurrence = "daily"
+  }
+
+  data = [
+    {
+      type   = "command"
+      target = "dtmi:example:thermostat;1"
+      path   = "syncConfiguration"
+      value  = jsonencode({ source = "central" })
+    },
+    {
+      type   = "cloudProperty"
+      target = "dtmi:example:thermostat;1"
+      path   = "lastSync"
+      value  = jsonencode("nightly")
+    }
+  ]
+}
@@ -0,0 +1,185 @@
+package iotcentral
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// apiVersion is the IotCentral REST API version of the requests sent by the
+// provider itself, matching the version used by the IotCentral client.
+const apiVersion = "2022-10-31-preview"
+
+// doAPIRequest sends a request for an API endpoint that the IotCentral client
+// does not cover. The request body is encoded from in and the response body
+// decoded into out when they are not nil. Errors use the same format as the
+// IotCentral client, so isNotFound works for both.
+func doAPIRequest(ctx context.Context, client *iotcentral.Client, method, path string, in, out any, expectedStatus int) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, apiURL(client, path), body)
+	if err != nil {
+		return err
+	}
+
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != expectedStatus {
+		return apiError(res, resBody)
+	}
+
+	if out != nil {
+		return json.Unmarshal(resBody, out)
+	}
+
+	return nil
+}
+
+// mergePatch encodes a request as a JSON merge patch. Fields listed in clear
+// that the request omits are set to null, so the API removes their previous
+// values. Nested fields are separated by dots, such as schedule.end.
+func mergePatch(request any, clear ...string) (map[string]json.RawMessage, error) {
+	b, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var patch map[string]json.RawMessage
+	if err := json.Unmarshal(b, &patch); err != nil {
+		return nil, err
+	}
+
+	for _, field := range clear {
+		if err := clearField(patch, strings.Split(field, ".")); err != nil {
+			return nil, err
+		}
+	}
+
+	return patch, nil
+}
+
+// clearField sets a field of a JSON object to null when it is absent. Fields
+// of absent parent objects are left alone.
+func clearField(object map[string]json.RawMessage, path []string) error {
+	value, ok := object[path[0]]
+	if len(path) == 1 {
+		if !ok {
+			object[path[0]] = json.RawMessage("null")
+		}
+
+		return nil
+	}
+
+	if !ok || string(value) == "null" {
+		return nil
+	}
+
+	var nested map[string]json.RawMessage
+	if err := json.Unmarshal(value, &nested); err != nil {
+		return err
+	}
+
+	if err := clearField(nested, path[1:]); err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(nested)
+	if err != nil {
+		return err
+	}
+
+	object[path[0]] = b
+	return nil
+}
+
+// apiURL returns the URL of an API path. Absolute URLs, such as the nextLink
+// of a collection page, are used as they are.
+func apiURL(client *iotcentral.Client, path string) string {
+	if strings.HasPrefix(path, "https://") || strings.HasPrefix(path, "http://") {
+		return path
+	}
+
+	url := client.HostURL + "/api/" + strings.TrimPrefix(path, "/")
+	if strings.Contains(url, "?") {
+		return url + "&api-version=" + apiVersion
+	}
+
+	return url + "?api-version=" + apiVersion
+}
+
+// getAllAPIPages fetches every page of an API collection, following the
+// nextLink of each page.
+func getAllAPIPages[T any](ctx context.Context, client *iotcentral.Client, path string) ([]T, error) {
+	var all []T
+
+	for path != "" {
+		var page struct {
+			Value    []T    `json:"value"`
+			NextLink string `json:"nextLink,omitempty"`
+		}
+
+		if err := doAPIRequest(ctx, client, http.MethodGet, path, nil, &page, http.StatusOK); err != nil {
+			return nil, err
+		}
+
+		all = append(all, page.Value...)
+
+		// Update the path to the next page if it's available, otherwise break the loop
+		path = page.NextLink
+	}
+
+	return all, nil
+}
+
+// pollWait waits for the poll interval of an asynchronous operation, or until
+// the context is done.
+func pollWait(ctx context.Context, interval time.Duration) error {
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// redactSecrets replaces every occurrence of the secrets in a message, so
+// secrets echoed by the API never end up in diagnostics.
+func redactSecrets(message string, secrets ...string) string {
+	for _, secret := range secrets {
+		if secret != "" {
+			message = strings.ReplaceAll(message, secret, "<redacted>")
+		}
+	}
+
+	return message
+}
@@ -0,0 +1,45 @@
+package iotcentral
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// apiToken is an IotCentral API token. The token itself is only returned
+// when the API token is created.
+type apiToken struct {
+	ID     string                      `json:"id,omitempty"`
+	Roles  []iotcentral.RoleAssignment `json:"roles"`
+	Expiry string                      `json:"expiry,omitempty"`
+	Token  string                      `json:"token,omitempty"`
+}
+
+// getAPIToken returns a specific API token.
+func getAPIToken(ctx context.Context, client *iotcentral.Client, tokenID string) (*apiToken, error) {
+	response := apiToken{}
+	err := doAPIRequest(ctx, client, http.MethodGet, "apiTokens/"+url.PathEscape(tokenID), nil, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// createAPIToken creates a new API token.
+func createAPIToken(ctx context.Context, client *iotcentral.Client, tokenID string, request apiToken) (*apiToken, error) {
+	response := apiToken{}
+	err := doAPIRequest(ctx, client, http.MethodPut, "apiTokens/"+url.PathEscape(tokenID), request, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// deleteAPIToken deletes an API token.
+func deleteAPIToken(ctx context.Context, client *iotcentral.Client, tokenID string) error {
+	return doAPIRequest(ctx, client, http.MethodDelete, "apiTokens/"+url.PathEscape(tokenID), nil, nil, http.StatusNoContent)
+}
@@ -0,0 +1,66 @@
+package iotcentral
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// dataExportSources are the sources of data that can be exported.
+var dataExportSources = []string{"telemetry", "properties", "deviceLifecycle", "deviceTemplateLifecycle", "deviceConnectivity", "audit"}
+
+// dataExport is an IotCentral data export.
+type dataExport struct {
+	ID           string                          `json:"id,omitempty"`
+	DisplayName  string                          `json:"displayName"`
+	Enabled      bool                            `json:"enabled"`
+	Source       string                          `json:"source"`
+	Filter       string                          `json:"filter,omitempty"`
+	Enrichments  map[string]dataExportEnrichment `json:"enrichments"`
+	Destinations []dataExportDestination         `json:"destinations"`
+	Status       string                          `json:"status,omitempty"`
+}
+
+// dataExportEnrichment is an enrichment of exported messages, either a static
+// value or a property of the device.
+type dataExportEnrichment struct {
+	Value  string `json:"value,omitempty"`
+	Target string `json:"target,omitempty"`
+	Path   string `json:"path,omitempty"`
+}
+
+// dataExportDestination is a reference to a destination of a data export.
+type dataExportDestination struct {
+	ID        string `json:"id"`
+	Transform string `json:"transform,omitempty"`
+}
+
+// getDataExport returns a specific data export.
+func getDataExport(ctx context.Context, client *iotcentral.Client, exportID string) (*dataExport, error) {
+	response := dataExport{}
+	err := doAPIRequest(ctx, client, http.MethodGet, "dataExport/exports/"+url.PathEscape(exportID), nil, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// putDataExport creates a data export or replaces an existing one. Settings
+// omitted from the request, such as removed enrichments, are removed.
+func putDataExport(ctx context.Context, client *iotcentral.Client, exportID string, request dataExport) (*dataExport, error) {
+	response := dataExport{}
+	err := doAPIRequest(ctx, client, http.MethodPut, "dataExport/exports/"+url.PathEscape(exportID), request, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// deleteDataExport deletes a data export.
+func deleteDataExport(ctx context.Context, client *iotcentral.Client, exportID string) error {
+	return doAPIRequest(ctx, client, http.MethodDelete, "dataExport/exports/"+url.PathEscape(exportID), nil, nil, http.StatusNoContent)
+}
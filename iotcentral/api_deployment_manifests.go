@@ -0,0 +1,78 @@
+package iotcentral
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// deploymentManifest is an IotCentral deployment manifest of IoT Edge modules.
+type deploymentManifest struct {
+	ID            string          `json:"id,omitempty"`
+	DisplayName   string          `json:"displayName"`
+	Data          json.RawMessage `json:"data"`
+	Organizations []string        `json:"organizations"`
+	Etag          string          `json:"etag,omitempty"`
+}
+
+// getDeploymentManifest returns a specific deployment manifest.
+func getDeploymentManifest(ctx context.Context, client *iotcentral.Client, manifestID string) (*deploymentManifest, error) {
+	response := deploymentManifest{}
+	err := doAPIRequest(ctx, client, http.MethodGet, "deploymentManifests/"+url.PathEscape(manifestID), nil, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// createDeploymentManifest creates a new deployment manifest.
+func createDeploymentManifest(ctx context.Context, client *iotcentral.Client, manifestID string, request deploymentManifest) (*deploymentManifest, error) {
+	response := deploymentManifest{}
+	err := doAPIRequest(ctx, client, http.MethodPut, "deploymentManifests/"+url.PathEscape(manifestID), request, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// updateDeploymentManifest updates an existing deployment manifest.
+func updateDeploymentManifest(ctx context.Context, client *iotcentral.Client, manifestID string, request deploymentManifest) (*deploymentManifest, error) {
+	response := deploymentManifest{}
+	err := doAPIRequest(ctx, client, http.MethodPatch, "deploymentManifests/"+url.PathEscape(manifestID), request, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// deleteDeploymentManifest deletes a deployment manifest.
+func deleteDeploymentManifest(ctx context.Context, client *iotcentral.Client, manifestID string) error {
+	return doAPIRequest(ctx, client, http.MethodDelete, "deploymentManifests/"+url.PathEscape(manifestID), nil, nil, http.StatusNoContent)
+}
+
+// getDeviceDeploymentManifest returns the deployment manifest assigned to an
+// IoT Edge device, or nil when it has none.
+func getDeviceDeploymentManifest(ctx context.Context, client *iotcentral.Client, deviceID string) (*deploymentManifest, error) {
+	response := struct {
+		DeploymentManifest *deploymentManifest `json:"deploymentManifest"`
+	}{}
+
+	err := doAPIRequest(ctx, client, http.MethodGet, "devices/"+url.PathEscape(deviceID), nil, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.DeploymentManifest, nil
+}
+
+// applyDeviceDeploymentManifest assigns a deployment manifest to an IoT Edge
+// device and applies its modules.
+func applyDeviceDeploymentManifest(ctx context.Context, client *iotcentral.Client, deviceID string, manifest deploymentManifest) error {
+	return doAPIRequest(ctx, client, http.MethodPost, "devices/"+url.PathEscape(deviceID)+"/applyDeploymentManifest", manifest, nil, http.StatusOK)
+}
@@ -0,0 +1,93 @@
+package iotcentral
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// Destination types of the IotCentral API.
+const (
+	destinationTypeEventHubs       = "eventhubs@v1"
+	destinationTypeServiceBusQueue = "servicebusqueue@v1"
+	destinationTypeServiceBusTopic = "servicebustopic@v1"
+	destinationTypeBlobStorage     = "blobstorage@v1"
+	destinationTypeDataExplorer    = "dataexplorer@v1"
+	destinationTypeWebhook         = "webhook@v1"
+)
+
+// Authorization types of a destination.
+const (
+	destinationAuthConnectionString = "connectionString"
+	destinationAuthManagedIdentity  = "systemAssignedManagedIdentity"
+	destinationAuthServicePrincipal = "servicePrincipal"
+)
+
+// destination is an IotCentral data export destination. Secrets such as
+// connection strings are never returned by the API.
+type destination struct {
+	ID                   string                              `json:"id,omitempty"`
+	DisplayName          string                              `json:"displayName"`
+	Type                 string                              `json:"type"`
+	Authorization        *destinationAuthorization           `json:"authorization,omitempty"`
+	ClusterURL           string                              `json:"clusterUrl,omitempty"`
+	Database             string                              `json:"database,omitempty"`
+	Table                string                              `json:"table,omitempty"`
+	URL                  string                              `json:"url,omitempty"`
+	HeaderCustomizations map[string]destinationCustomization `json:"headerCustomizations,omitempty"`
+	QueryCustomizations  map[string]destinationCustomization `json:"queryCustomizations,omitempty"`
+	Status               string                              `json:"status,omitempty"`
+}
+
+// destinationAuthorization is the authorization of a destination. The fields
+// in use depend on the destination and authorization type.
+type destinationAuthorization struct {
+	Type             string `json:"type"`
+	ConnectionString string `json:"connectionString,omitempty"`
+	HostName         string `json:"hostName,omitempty"`
+	EndpointURI      string `json:"endpointUri,omitempty"`
+	EventHubName     string `json:"eventHubName,omitempty"`
+	QueueName        string `json:"queueName,omitempty"`
+	TopicName        string `json:"topicName,omitempty"`
+	ContainerName    string `json:"containerName,omitempty"`
+	ClientID         string `json:"clientId,omitempty"`
+	TenantID         string `json:"tenantId,omitempty"`
+	ClientSecret     string `json:"clientSecret,omitempty"`
+}
+
+// destinationCustomization is a header or query parameter of a webhook
+// destination.
+type destinationCustomization struct {
+	Value  string `json:"value"`
+	Secret bool   `json:"secret"`
+}
+
+// getDestination returns a specific destination.
+func getDestination(ctx context.Context, client *iotcentral.Client, destinationID string) (*destination, error) {
+	response := destination{}
+	err := doAPIRequest(ctx, client, http.MethodGet, "dataExport/destinations/"+url.PathEscape(destinationID), nil, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// putDestination creates a destination or replaces an existing one. Settings
+// omitted from the request, such as removed webhook headers, are removed.
+func putDestination(ctx context.Context, client *iotcentral.Client, destinationID string, request destination) (*destination, error) {
+	response := destination{}
+	err := doAPIRequest(ctx, client, http.MethodPut, "dataExport/destinations/"+url.PathEscape(destinationID), request, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// deleteDestination deletes a destination.
+func deleteDestination(ctx context.Context, client *iotcentral.Client, destinationID string) error {
+	return doAPIRequest(ctx, client, http.MethodDelete, "dataExport/destinations/"+url.PathEscape(destinationID), nil, nil, http.StatusNoContent)
+}
@@ -0,0 +1,56 @@
+package iotcentral
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// deviceCredentials are the credentials a device uses to provision itself
+// through the Device Provisioning Service.
+type deviceCredentials struct {
+	IDScope      string                   `json:"idScope"`
+	SymmetricKey *symmetricKeyAttestation `json:"symmetricKey,omitempty"`
+	X509         *x509DeviceAttestation   `json:"x509,omitempty"`
+	TPM          *tpmAttestation          `json:"tpm,omitempty"`
+}
+
+// symmetricKeyAttestation is a symmetric key attestation.
+type symmetricKeyAttestation struct {
+	PrimaryKey   string `json:"primaryKey"`
+	SecondaryKey string `json:"secondaryKey"`
+}
+
+// x509DeviceAttestation is an X.509 attestation of a single device.
+type x509DeviceAttestation struct {
+	ClientCertificates struct {
+		Primary   *x509Certificate `json:"primary,omitempty"`
+		Secondary *x509Certificate `json:"secondary,omitempty"`
+	} `json:"clientCertificates"`
+}
+
+// x509Certificate is an X.509 certificate.
+type x509Certificate struct {
+	Certificate string `json:"certificate,omitempty"`
+	Info        struct {
+		SHA1Thumbprint string `json:"sha1Thumbprint"`
+	} `json:"info"`
+}
+
+// tpmAttestation is a TPM attestation.
+type tpmAttestation struct {
+	EndorsementKey string `json:"endorsementKey"`
+}
+
+// getDeviceCredentials returns the credentials of a device.
+func getDeviceCredentials(ctx context.Context, client *iotcentral.Client, deviceID string) (*deviceCredentials, error) {
+	credentials := deviceCredentials{}
+	err := doAPIRequest(ctx, client, http.MethodGet, "devices/"+url.PathEscape(deviceID)+"/credentials", nil, &credentials, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &credentials, nil
+}
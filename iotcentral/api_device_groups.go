@@ -0,0 +1,57 @@
+package iotcentral
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// deviceGroup is an IotCentral device group.
+type deviceGroup struct {
+	ID            string   `json:"id,omitempty"`
+	DisplayName   string   `json:"displayName"`
+	Filter        string   `json:"filter"`
+	Description   string   `json:"description"`
+	Etag          string   `json:"etag,omitempty"`
+	Organizations []string `json:"organizations"`
+}
+
+// getDeviceGroup returns a specific device group.
+func getDeviceGroup(ctx context.Context, client *iotcentral.Client, deviceGroupID string) (*deviceGroup, error) {
+	response := deviceGroup{}
+	err := doAPIRequest(ctx, client, http.MethodGet, "deviceGroups/"+url.PathEscape(deviceGroupID), nil, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// createDeviceGroup creates a new device group.
+func createDeviceGroup(ctx context.Context, client *iotcentral.Client, deviceGroupID string, request deviceGroup) (*deviceGroup, error) {
+	response := deviceGroup{}
+	err := doAPIRequest(ctx, client, http.MethodPut, "deviceGroups/"+url.PathEscape(deviceGroupID), request, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// updateDeviceGroup updates an existing device group.
+func updateDeviceGroup(ctx context.Context, client *iotcentral.Client, deviceGroupID string, request deviceGroup) (*deviceGroup, error) {
+	response := deviceGroup{}
+	err := doAPIRequest(ctx, client, http.MethodPatch, "deviceGroups/"+url.PathEscape(deviceGroupID), request, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// deleteDeviceGroup deletes a device group.
+func deleteDeviceGroup(ctx context.Context, client *iotcentral.Client, deviceGroupID string) error {
+	return doAPIRequest(ctx, client, http.MethodDelete, "deviceGroups/"+url.PathEscape(deviceGroupID), nil, nil, http.StatusNoContent)
+}
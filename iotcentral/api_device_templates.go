@@ -0,0 +1,48 @@
+package iotcentral
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// deviceTemplate is an IotCentral device template.
+type deviceTemplate struct {
+	ID              string          `json:"@id,omitempty"`
+	Types           []string        `json:"@type"`
+	DisplayName     string          `json:"displayName,omitempty"`
+	Description     string          `json:"description,omitempty"`
+	CapabilityModel json.RawMessage `json:"capabilityModel"`
+	Etag            string          `json:"etag,omitempty"`
+}
+
+// getDeviceTemplate returns a specific device template.
+func getDeviceTemplate(ctx context.Context, client *iotcentral.Client, templateID string) (*deviceTemplate, error) {
+	template := deviceTemplate{}
+	err := doAPIRequest(ctx, client, http.MethodGet, "deviceTemplates/"+url.PathEscape(templateID), nil, &template, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+// putDeviceTemplate publishes a new device template or a new version of an
+// existing one.
+func putDeviceTemplate(ctx context.Context, client *iotcentral.Client, templateID string, template deviceTemplate) (*deviceTemplate, error) {
+	response := deviceTemplate{}
+	err := doAPIRequest(ctx, client, http.MethodPut, "deviceTemplates/"+url.PathEscape(templateID), template, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// deleteDeviceTemplate deletes a device template.
+func deleteDeviceTemplate(ctx context.Context, client *iotcentral.Client, templateID string) error {
+	return doAPIRequest(ctx, client, http.MethodDelete, "deviceTemplates/"+url.PathEscape(templateID), nil, nil, http.StatusNoContent)
+}
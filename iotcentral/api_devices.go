@@ -0,0 +1,61 @@
+package iotcentral
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// device is an IotCentral device. Unlike iotcentral.DeviceRequest, the flags
+// are always sent, so devices can be created disabled and simulation can be
+// turned off again.
+type device struct {
+	ID            string   `json:"id,omitempty"`
+	Etag          string   `json:"etag,omitempty"`
+	DisplayName   string   `json:"displayName"`
+	Template      string   `json:"template,omitempty"`
+	Simulated     bool     `json:"simulated"`
+	Provisioned   bool     `json:"provisioned,omitempty"`
+	Enabled       bool     `json:"enabled"`
+	Organizations []string `json:"organizations"`
+}
+
+// getDevice returns a specific device.
+func getDevice(ctx context.Context, client *iotcentral.Client, deviceID string) (*device, error) {
+	response := device{}
+	err := doAPIRequest(ctx, client, http.MethodGet, "devices/"+url.PathEscape(deviceID), nil, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// createDevice creates a new device.
+func createDevice(ctx context.Context, client *iotcentral.Client, deviceID string, request device) (*device, error) {
+	response := device{}
+	err := doAPIRequest(ctx, client, http.MethodPut, "devices/"+url.PathEscape(deviceID), request, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// updateDevice updates an existing device.
+func updateDevice(ctx context.Context, client *iotcentral.Client, deviceID string, request device) (*device, error) {
+	response := device{}
+	err := doAPIRequest(ctx, client, http.MethodPatch, "devices/"+url.PathEscape(deviceID), request, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// deleteDevice deletes a device.
+func deleteDevice(ctx context.Context, client *iotcentral.Client, deviceID string) error {
+	return doAPIRequest(ctx, client, http.MethodDelete, "devices/"+url.PathEscape(deviceID), nil, nil, http.StatusNoContent)
+}
@@ -0,0 +1,141 @@
+package iotcentral
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// Attestation types of an enrollment group.
+const (
+	attestationTypeSymmetricKey = "symmetricKey"
+	attestationTypeX509         = "x509"
+)
+
+// enrollmentGroup is an IotCentral enrollment group.
+type enrollmentGroup struct {
+	ID          string                      `json:"id,omitempty"`
+	DisplayName string                      `json:"displayName"`
+	Enabled     bool                        `json:"enabled"`
+	Type        string                      `json:"type,omitempty"`
+	Attestation *enrollmentGroupAttestation `json:"attestation,omitempty"`
+	IDScope     string                      `json:"idScope,omitempty"`
+	Etag        string                      `json:"etag,omitempty"`
+}
+
+// enrollmentGroupAttestation is the attestation of an enrollment group.
+type enrollmentGroupAttestation struct {
+	Type         string                   `json:"type"`
+	SymmetricKey *symmetricKeyAttestation `json:"symmetricKey,omitempty"`
+}
+
+// enrollmentGroupCertificate is a primary or secondary X.509 certificate of
+// an enrollment group.
+type enrollmentGroupCertificate struct {
+	Verified    bool   `json:"verified"`
+	Certificate string `json:"certificate,omitempty"`
+	Etag        string `json:"etag,omitempty"`
+	Info        *struct {
+		SHA1Thumbprint string `json:"sha1Thumbprint"`
+	} `json:"info,omitempty"`
+}
+
+// enrollmentGroupPath returns the API path of an enrollment group.
+func enrollmentGroupPath(groupID string) string {
+	return "enrollmentGroups/" + url.PathEscape(groupID)
+}
+
+// enrollmentGroupCertificatePath returns the API path of a certificate entry
+// of an enrollment group.
+func enrollmentGroupCertificatePath(groupID, entry string) string {
+	return enrollmentGroupPath(groupID) + "/certificates/" + url.PathEscape(entry)
+}
+
+// getEnrollmentGroup returns a specific enrollment group.
+func getEnrollmentGroup(ctx context.Context, client *iotcentral.Client, groupID string) (*enrollmentGroup, error) {
+	response := enrollmentGroup{}
+	err := doAPIRequest(ctx, client, http.MethodGet, enrollmentGroupPath(groupID), nil, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// createEnrollmentGroup creates a new enrollment group.
+func createEnrollmentGroup(ctx context.Context, client *iotcentral.Client, groupID string, request enrollmentGroup) (*enrollmentGroup, error) {
+	response := enrollmentGroup{}
+	err := doAPIRequest(ctx, client, http.MethodPut, enrollmentGroupPath(groupID), request, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// updateEnrollmentGroup updates an existing enrollment group.
+func updateEnrollmentGroup(ctx context.Context, client *iotcentral.Client, groupID string, request enrollmentGroup) (*enrollmentGroup, error) {
+	response := enrollmentGroup{}
+	err := doAPIRequest(ctx, client, http.MethodPatch, enrollmentGroupPath(groupID), request, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// deleteEnrollmentGroup deletes an enrollment group.
+func deleteEnrollmentGroup(ctx context.Context, client *iotcentral.Client, groupID string) error {
+	return doAPIRequest(ctx, client, http.MethodDelete, enrollmentGroupPath(groupID), nil, nil, http.StatusNoContent)
+}
+
+// getEnrollmentGroupCertificate returns a certificate of an enrollment group.
+func getEnrollmentGroupCertificate(ctx context.Context, client *iotcentral.Client, groupID, entry string) (*enrollmentGroupCertificate, error) {
+	response := enrollmentGroupCertificate{}
+	err := doAPIRequest(ctx, client, http.MethodGet, enrollmentGroupCertificatePath(groupID, entry), nil, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// putEnrollmentGroupCertificate sets a certificate of an enrollment group.
+func putEnrollmentGroupCertificate(ctx context.Context, client *iotcentral.Client, groupID, entry string, request enrollmentGroupCertificate) (*enrollmentGroupCertificate, error) {
+	response := enrollmentGroupCertificate{}
+	err := doAPIRequest(ctx, client, http.MethodPut, enrollmentGroupCertificatePath(groupID, entry), request, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// deleteEnrollmentGroupCertificate removes a certificate of an enrollment group.
+func deleteEnrollmentGroupCertificate(ctx context.Context, client *iotcentral.Client, groupID, entry string) error {
+	return doAPIRequest(ctx, client, http.MethodDelete, enrollmentGroupCertificatePath(groupID, entry), nil, nil, http.StatusNoContent)
+}
+
+// generateEnrollmentGroupVerificationCode generates the code that proves
+// possession of the private key of a certificate of an enrollment group.
+func generateEnrollmentGroupVerificationCode(ctx context.Context, client *iotcentral.Client, groupID, entry string) (string, error) {
+	var response struct {
+		VerificationCode string `json:"verificationCode"`
+	}
+
+	err := doAPIRequest(ctx, client, http.MethodPost, enrollmentGroupCertificatePath(groupID, entry)+"/generateVerificationCode", nil, &response, http.StatusOK)
+	if err != nil {
+		return "", err
+	}
+
+	return response.VerificationCode, nil
+}
+
+// verifyEnrollmentGroupCertificate verifies a certificate of an enrollment
+// group with a certificate signed by it for the verification code.
+func verifyEnrollmentGroupCertificate(ctx context.Context, client *iotcentral.Client, groupID, entry, verificationCertificate string) error {
+	request := map[string]string{"certificate": verificationCertificate}
+	return doAPIRequest(ctx, client, http.MethodPost, enrollmentGroupCertificatePath(groupID, entry)+"/verify", request, nil, http.StatusNoContent)
+}
@@ -0,0 +1,94 @@
+package iotcentral
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// fileUploadPollInterval is how often the provisioning state of the file
+// upload configuration is checked.
+const fileUploadPollInterval = 5 * time.Second
+
+// fileUpload is the file upload configuration of an IotCentral application.
+type fileUpload struct {
+	Account          string `json:"account,omitempty"`
+	ConnectionString string `json:"connectionString"`
+	Container        string `json:"container"`
+	SasTTL           string `json:"sasTtl,omitempty"`
+	State            string `json:"state,omitempty"`
+	Etag             string `json:"etag,omitempty"`
+}
+
+// getFileUpload returns the file upload configuration.
+func getFileUpload(ctx context.Context, client *iotcentral.Client) (*fileUpload, error) {
+	response := fileUpload{}
+	err := doAPIRequest(ctx, client, http.MethodGet, "fileUploads", nil, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// putFileUpload creates the file upload configuration or replaces the
+// existing one. Settings omitted from the request, such as a removed account,
+// are removed.
+func putFileUpload(ctx context.Context, client *iotcentral.Client, request fileUpload) (*fileUpload, error) {
+	response := fileUpload{}
+	err := doAPIRequest(ctx, client, http.MethodPut, "fileUploads", request, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// deleteFileUpload deletes the file upload configuration.
+func deleteFileUpload(ctx context.Context, client *iotcentral.Client) error {
+	return doAPIRequest(ctx, client, http.MethodDelete, "fileUploads", nil, nil, http.StatusNoContent)
+}
+
+// waitForFileUpload polls the file upload configuration until it is no longer
+// being provisioned, and fails when provisioning failed.
+func waitForFileUpload(ctx context.Context, client *iotcentral.Client) (*fileUpload, error) {
+	for {
+		upload, err := getFileUpload(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+
+		switch upload.State {
+		case "pending", "updating", "deleting":
+		case "failed":
+			return nil, fmt.Errorf("provisioning of the file upload configuration failed")
+		default:
+			return upload, nil
+		}
+
+		if err := pollWait(ctx, fileUploadPollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// waitForFileUploadDeleted polls the file upload configuration until it is gone.
+func waitForFileUploadDeleted(ctx context.Context, client *iotcentral.Client) error {
+	for {
+		_, err := getFileUpload(ctx, client)
+		if isNotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := pollWait(ctx, fileUploadPollInterval); err != nil {
+			return err
+		}
+	}
+}
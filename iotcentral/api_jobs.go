@@ -0,0 +1,189 @@
+package iotcentral
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// jobPollInterval is how often the status of a job is checked while waiting
+// for it to complete.
+const jobPollInterval = 10 * time.Second
+
+// jobDefinition holds the settings shared by jobs and scheduled jobs.
+type jobDefinition struct {
+	DisplayName           string                    `json:"displayName"`
+	Description           string                    `json:"description,omitempty"`
+	Group                 string                    `json:"group"`
+	Batch                 *jobBatch                 `json:"batch,omitempty"`
+	CancellationThreshold *jobCancellationThreshold `json:"cancellationThreshold,omitempty"`
+	Data                  []jobData                 `json:"data"`
+	Organizations         []string                  `json:"organizations"`
+}
+
+// jobBatch controls how many devices a job updates at a time.
+type jobBatch struct {
+	Type  string `json:"type"`
+	Value int64  `json:"value"`
+}
+
+// jobCancellationThreshold controls how many device failures cancel a job.
+type jobCancellationThreshold struct {
+	Type  string `json:"type"`
+	Value int64  `json:"value"`
+	Batch bool   `json:"batch"`
+}
+
+// jobData is an operation a job runs on every device, such as setting a
+// property or calling a command.
+type jobData struct {
+	Type   string          `json:"type"`
+	Target string          `json:"target"`
+	Path   string          `json:"path"`
+	Value  json.RawMessage `json:"value,omitempty"`
+}
+
+// job is an IotCentral job.
+type job struct {
+	jobDefinition
+	ID     string `json:"id,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+// jobDeviceStatus is the status of a job on a single device.
+type jobDeviceStatus struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+// scheduledJob is an IotCentral scheduled job.
+type scheduledJob struct {
+	jobDefinition
+	ID        string      `json:"id,omitempty"`
+	Schedule  jobSchedule `json:"schedule"`
+	Enabled   bool        `json:"enabled"`
+	Completed bool        `json:"completed,omitempty"`
+	Etag      string      `json:"etag,omitempty"`
+}
+
+// jobSchedule is when a scheduled job runs.
+type jobSchedule struct {
+	Start      string          `json:"start"`
+	Recurrence string          `json:"recurrence,omitempty"`
+	End        *jobScheduleEnd `json:"end,omitempty"`
+}
+
+// jobScheduleEnd ends a recurring schedule on a date or after a number of
+// occurrences.
+type jobScheduleEnd struct {
+	Type        string `json:"type"`
+	Date        string `json:"date,omitempty"`
+	Occurrences int64  `json:"occurrences,omitempty"`
+}
+
+// jobFinished reports whether a job status is final.
+func jobFinished(status string) bool {
+	switch status {
+	case "complete", "completed", "failed", "stopped", "cancelled":
+		return true
+	default:
+		return false
+	}
+}
+
+// getJob returns a specific job.
+func getJob(ctx context.Context, client *iotcentral.Client, jobID string) (*job, error) {
+	response := job{}
+	err := doAPIRequest(ctx, client, http.MethodGet, "jobs/"+url.PathEscape(jobID), nil, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// createJob creates and starts a new job.
+func createJob(ctx context.Context, client *iotcentral.Client, jobID string, request job) (*job, error) {
+	response := job{}
+	err := doAPIRequest(ctx, client, http.MethodPut, "jobs/"+url.PathEscape(jobID), request, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// stopJob stops a running job.
+func stopJob(ctx context.Context, client *iotcentral.Client, jobID string) error {
+	return doAPIRequest(ctx, client, http.MethodPost, "jobs/"+url.PathEscape(jobID)+"/stop", nil, nil, http.StatusNoContent)
+}
+
+// getJobDevices returns the status of a job on each of its devices.
+func getJobDevices(ctx context.Context, client *iotcentral.Client, jobID string) ([]jobDeviceStatus, error) {
+	return getAllAPIPages[jobDeviceStatus](ctx, client, "jobs/"+url.PathEscape(jobID)+"/devices")
+}
+
+// waitForJob polls a job until its status is final.
+func waitForJob(ctx context.Context, client *iotcentral.Client, jobID string) (*job, error) {
+	for {
+		job, err := getJob(ctx, client, jobID)
+		if err != nil {
+			return nil, err
+		}
+
+		if jobFinished(job.Status) {
+			return job, nil
+		}
+
+		if err := pollWait(ctx, jobPollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// getScheduledJob returns a specific scheduled job.
+func getScheduledJob(ctx context.Context, client *iotcentral.Client, jobID string) (*scheduledJob, error) {
+	response := scheduledJob{}
+	err := doAPIRequest(ctx, client, http.MethodGet, "scheduledJobs/"+url.PathEscape(jobID), nil, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// createScheduledJob creates a new scheduled job.
+func createScheduledJob(ctx context.Context, client *iotcentral.Client, jobID string, request scheduledJob) (*scheduledJob, error) {
+	response := scheduledJob{}
+	err := doAPIRequest(ctx, client, http.MethodPut, "scheduledJobs/"+url.PathEscape(jobID), request, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// updateScheduledJob updates an existing scheduled job.
+func updateScheduledJob(ctx context.Context, client *iotcentral.Client, jobID string, request scheduledJob) (*scheduledJob, error) {
+	patch, err := mergePatch(request, "description", "batch", "cancellationThreshold", "schedule.recurrence", "schedule.end")
+	if err != nil {
+		return nil, err
+	}
+
+	response := scheduledJob{}
+	err = doAPIRequest(ctx, client, http.MethodPatch, "scheduledJobs/"+url.PathEscape(jobID), patch, &response, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// deleteScheduledJob deletes a scheduled job.
+func deleteScheduledJob(ctx context.Context, client *iotcentral.Client, jobID string) error {
+	return doAPIRequest(ctx, client, http.MethodDelete, "scheduledJobs/"+url.PathEscape(jobID), nil, nil, http.StatusNoContent)
+}
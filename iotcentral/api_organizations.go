@@ -0,0 +1,13 @@
+package iotcentral
+
+import (
+	"context"
+
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// getOrganizations returns every organization of the application. Unlike
+// iotcentral.Client.GetOrganizations, it follows the nextLink of each page.
+func getOrganizations(ctx context.Context, client *iotcentral.Client) ([]iotcentral.OrganizationResponse, error) {
+	return getAllAPIPages[iotcentral.OrganizationResponse](ctx, client, "organizations")
+}
@@ -0,0 +1,74 @@
+package iotcentral
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMergePatch(t *testing.T) {
+	type schedule struct {
+		Start string `json:"start"`
+		End   string `json:"end,omitempty"`
+	}
+
+	type request struct {
+		Name     string    `json:"name"`
+		Filter   string    `json:"filter,omitempty"`
+		Schedule *schedule `json:"schedule,omitempty"`
+	}
+
+	tests := []struct {
+		name    string
+		request request
+		clear   []string
+		want    string
+	}{
+		{
+			name:    "set fields are kept",
+			request: request{Name: "a", Filter: "f"},
+			clear:   []string{"filter"},
+			want:    `{"filter":"f","name":"a"}`,
+		},
+		{
+			name:    "omitted fields are cleared",
+			request: request{Name: "a"},
+			clear:   []string{"filter"},
+			want:    `{"filter":null,"name":"a"}`,
+		},
+		{
+			name:    "other omitted fields are left out",
+			request: request{Name: "a"},
+			want:    `{"name":"a"}`,
+		},
+		{
+			name:    "nested fields are cleared",
+			request: request{Name: "a", Schedule: &schedule{Start: "s"}},
+			clear:   []string{"schedule.end"},
+			want:    `{"name":"a","schedule":{"end":null,"start":"s"}}`,
+		},
+		{
+			name:    "fields of omitted parents are left out",
+			request: request{Name: "a"},
+			clear:   []string{"schedule.end"},
+			want:    `{"name":"a"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch, err := mergePatch(tt.request, tt.clear...)
+			if err != nil {
+				t.Fatalf("mergePatch() error = %v", err)
+			}
+
+			got, err := json.Marshal(patch)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(got) != tt.want {
+				t.Errorf("mergePatch() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package iotcentral
+
+import (
+	"context"
+
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// user is a user of any type as returned by the users API.
+type user struct {
+	ID       string                      `json:"id"`
+	Type     string                      `json:"type"`
+	Email    string                      `json:"email,omitempty"`
+	ObjectID string                      `json:"objectId,omitempty"`
+	TenantID string                      `json:"tenantId,omitempty"`
+	Roles    []iotcentral.RoleAssignment `json:"roles"`
+}
+
+// userTypes are the types of users of an application.
+var userTypes = []string{"email", "adGroup", "servicePrincipal"}
+
+// getUsers returns every user of the application, regardless of its type.
+func getUsers(ctx context.Context, client *iotcentral.Client) ([]user, error) {
+	return getAllAPIPages[user](ctx, client, "users")
+}
@@ -0,0 +1,102 @@
+package iotcentral
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// normalizeHost turns an application URL into the https://<host> form used
+// by the client, adding a missing scheme and dropping trailing slashes.
+func normalizeHost(host string) (string, error) {
+	host = strings.TrimSpace(host)
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+
+	u, err := url.Parse(host)
+	if err != nil {
+		return "", err
+	}
+
+	if u.Scheme != "https" {
+		return "", fmt.Errorf("host %q must use the https scheme", host)
+	}
+
+	if u.Hostname() == "" {
+		return "", fmt.Errorf("host %q has no hostname", host)
+	}
+
+	if strings.Trim(u.Path, "/") != "" || u.RawQuery != "" || u.Fragment != "" {
+		return "", fmt.Errorf("host %q must not contain a path, query or fragment", host)
+	}
+
+	return "https://" + strings.ToLower(u.Host), nil
+}
+
+// iotAppCollectionResponse is a page of IoT Central applications returned by
+// Azure Resource Manager.
+type iotAppCollectionResponse struct {
+	Value []struct {
+		Properties struct {
+			ApplicationID string `json:"applicationId"`
+			Subdomain     string `json:"subdomain"`
+		} `json:"properties"`
+	} `json:"value"`
+	NextLink string `json:"nextLink,omitempty"`
+}
+
+// lookupApplicationSubdomain finds the subdomain of the IoT Central
+// application with the given application ID in an Azure subscription. The
+// client must authorize requests for Azure Resource Manager.
+func lookupApplicationSubdomain(ctx context.Context, client *http.Client, env cloudEnvironment, subscriptionID, applicationID string) (string, error) {
+	if env.ResourceManagerEndpoint == "" {
+		return "", fmt.Errorf("application IDs are not supported for the %s environment, set host instead", customEnvironment)
+	}
+
+	if subscriptionID == "" {
+		return "", errors.New("subscription_id is required to look up an application by its application ID")
+	}
+
+	appsURL := fmt.Sprintf("%s/subscriptions/%s/providers/Microsoft.IoTCentral/iotApps?api-version=2021-06-01", env.ResourceManagerEndpoint, url.PathEscape(subscriptionID))
+	for appsURL != "" {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, appsURL, nil)
+		if err != nil {
+			return "", err
+		}
+
+		res, err := client.Do(req)
+		if err != nil {
+			return "", err
+		}
+
+		var apps iotAppCollectionResponse
+		if res.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(res.Body)
+			res.Body.Close()
+			return "", fmt.Errorf("listing IoT Central applications, %w", apiError(res, body))
+		}
+
+		err = json.NewDecoder(res.Body).Decode(&apps)
+		res.Body.Close()
+		if err != nil {
+			return "", err
+		}
+
+		for _, app := range apps.Value {
+			if strings.EqualFold(app.Properties.ApplicationID, applicationID) {
+				return app.Properties.Subdomain, nil
+			}
+		}
+
+		// Update the URL to the next page if it's available, otherwise break the loop
+		appsURL = apps.NextLink
+	}
+
+	return "", fmt.Errorf("no IoT Central application with application ID %s found in subscription %s", applicationID, subscriptionID)
+}
@@ -0,0 +1,84 @@
+package iotcentral
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNormalizeHost(t *testing.T) {
+	tests := []struct {
+		name    string
+		host    string
+		want    string
+		wantErr bool
+	}{
+		{name: "url", host: "https://myapp.azureiotcentral.com", want: "https://myapp.azureiotcentral.com"},
+		{name: "missing scheme", host: "myapp.azureiotcentral.com", want: "https://myapp.azureiotcentral.com"},
+		{name: "trailing slash", host: "https://myapp.azureiotcentral.com/", want: "https://myapp.azureiotcentral.com"},
+		{name: "upper case and spaces", host: " https://MyApp.azureiotcentral.com ", want: "https://myapp.azureiotcentral.com"},
+		{name: "port", host: "https://localhost:8443", want: "https://localhost:8443"},
+		{name: "http scheme", host: "http://myapp.azureiotcentral.com", wantErr: true},
+		{name: "path", host: "https://myapp.azureiotcentral.com/api", wantErr: true},
+		{name: "query", host: "https://myapp.azureiotcentral.com?a=b", wantErr: true},
+		{name: "no hostname", host: "https://", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeHost(tt.host)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("normalizeHost(%q) error = %v, wantErr %v", tt.host, err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("normalizeHost(%q) = %q, want %q", tt.host, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookupApplicationSubdomain(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer arm" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/subscriptions/sub/providers/Microsoft.IoTCentral/iotApps":
+			fmt.Fprintf(w, `{"value": [{"properties": {"applicationId": "first", "subdomain": "one"}}], "nextLink": "%s/page2"}`, server.URL)
+		case "/page2":
+			fmt.Fprint(w, `{"value": [{"properties": {"applicationId": "Second", "subdomain": "two"}}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	env := cloudEnvironment{ResourceManagerEndpoint: server.URL}
+	client := newHTTPClient(clientConfig{
+		Auth:    apiTokenAuthorizer("Bearer arm"),
+		Limiter: newRequestLimiter(1),
+	})
+
+	subdomain, err := lookupApplicationSubdomain(context.Background(), client, env, "sub", "second")
+	if err != nil {
+		t.Fatalf("lookupApplicationSubdomain() error = %v", err)
+	}
+
+	if subdomain != "two" {
+		t.Errorf("lookupApplicationSubdomain() = %q, want %q", subdomain, "two")
+	}
+
+	if _, err := lookupApplicationSubdomain(context.Background(), client, env, "sub", "missing"); err == nil {
+		t.Error("lookupApplicationSubdomain() expected an error for an unknown application")
+	}
+
+	if _, err := lookupApplicationSubdomain(context.Background(), client, env, "other", "first"); err == nil {
+		t.Error("lookupApplicationSubdomain() expected an error for a failed request")
+	}
+}
@@ -0,0 +1,294 @@
+package iotcentral
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+const (
+	// tokenRefreshMargin is how long before expiry a cached access token is refreshed.
+	tokenRefreshMargin = 5 * time.Minute
+
+	// defaultMaxConcurrentRequests is the default number of API requests
+	// that may be in flight at the same time.
+	defaultMaxConcurrentRequests = 10
+)
+
+// clientConfig holds the settings of the IotCentral API client.
+type clientConfig struct {
+	Host    string
+	Auth    authorizer
+	Retry   retryPolicy
+	Limiter requestLimiter
+}
+
+// requestLimiter is a semaphore bounding the number of requests in flight.
+// Clients sharing a limiter share the bound.
+type requestLimiter chan struct{}
+
+// newRequestLimiter creates a requestLimiter allowing n concurrent requests.
+func newRequestLimiter(n int) requestLimiter {
+	return make(requestLimiter, n)
+}
+
+// newClient creates an IotCentral API client that authorizes and retries
+// every request according to the configuration.
+func newClient(config clientConfig) *iotcentral.Client {
+	return &iotcentral.Client{
+		HostURL:    config.Host,
+		HTTPClient: newHTTPClient(config),
+	}
+}
+
+// newHTTPClient creates an HTTP client that authorizes and retries every
+// request according to the configuration. The host is not used.
+func newHTTPClient(config clientConfig) *http.Client {
+	return &http.Client{
+		Transport: &apiTransport{
+			base:    http.DefaultTransport,
+			auth:    config.Auth,
+			retry:   config.Retry,
+			limiter: config.Limiter,
+		},
+	}
+}
+
+// authorizer provides the Authorization header value for API requests.
+type authorizer interface {
+	authorization(ctx context.Context) (string, error)
+}
+
+// bearerTokenAuthorizer obtains Azure access tokens from a credential and
+// caches them until they are about to expire.
+type bearerTokenAuthorizer struct {
+	credential azcore.TokenCredential
+	scopes     []string
+
+	mu    sync.Mutex
+	token azcore.AccessToken
+}
+
+// newBearerTokenAuthorizer creates a bearerTokenAuthorizer for the given scopes.
+func newBearerTokenAuthorizer(credential azcore.TokenCredential, scopes ...string) *bearerTokenAuthorizer {
+	return &bearerTokenAuthorizer{
+		credential: credential,
+		scopes:     scopes,
+	}
+}
+
+// authorization returns a bearer token, refreshing it when it is close to expiry.
+func (a *bearerTokenAuthorizer) authorization(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token.Token == "" || time.Now().Add(tokenRefreshMargin).After(a.token.ExpiresOn) {
+		token, err := a.credential.GetToken(ctx, policy.TokenRequestOptions{Scopes: a.scopes})
+		if err != nil {
+			return "", err
+		}
+
+		a.token = token
+	}
+
+	return "Bearer " + a.token.Token, nil
+}
+
+// apiTokenAuthorizer authorizes requests with an IotCentral API token.
+type apiTokenAuthorizer string
+
+// newAPITokenAuthorizer creates an apiTokenAuthorizer, adding the
+// SharedAccessSignature scheme when the token does not include it.
+func newAPITokenAuthorizer(token string) apiTokenAuthorizer {
+	if !strings.HasPrefix(token, "SharedAccessSignature ") {
+		token = "SharedAccessSignature " + token
+	}
+
+	return apiTokenAuthorizer(token)
+}
+
+// authorization returns the API token.
+func (a apiTokenAuthorizer) authorization(_ context.Context) (string, error) {
+	return string(a), nil
+}
+
+// apiTransport is the http.RoundTripper used by the IotCentral client.
+// It is shared by all resources and data sources of a provider instance.
+type apiTransport struct {
+	base  http.RoundTripper
+	auth  authorizer
+	retry retryPolicy
+
+	// limiter bounds the number of requests in flight.
+	limiter requestLimiter
+}
+
+// RoundTrip sends an API request, retrying throttled and transient failures.
+func (t *apiTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		res, err := t.send(req)
+		retry := err == nil && t.retry.shouldRetry(req.Method, res)
+		if err != nil {
+			// The request may have reached the API, so only requests that
+			// are safe to repeat are sent again
+			retry = isIdempotent(req.Method)
+			res = errorResponse(req, http.StatusServiceUnavailable, err)
+		}
+
+		if !retry || req.Context().Err() != nil {
+			return res, nil
+		}
+
+		if attempt > t.retry.MaxRetries {
+			if attempt > 1 {
+				annotateAttempts(res, attempt)
+				tflog.Warn(req.Context(), "Giving up on IotCentral API request", map[string]any{
+					"method":   req.Method,
+					"url":      req.URL.Redacted(),
+					"status":   res.StatusCode,
+					"attempts": attempt,
+				})
+			}
+
+			return res, nil
+		}
+
+		delay := t.retry.delay(attempt, res)
+
+		// Release the connection of the failed attempt before waiting
+		_, _ = io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return errorResponse(req, http.StatusServiceUnavailable, req.Context().Err()), nil
+		case <-timer.C:
+		}
+
+		if req.Body != nil && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return errorResponse(req, http.StatusInternalServerError, err), nil
+			}
+
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// send authorizes and sends a single attempt of an API request. It returns an
+// error when no response was received.
+func (t *apiTransport) send(req *http.Request) (*http.Response, error) {
+	authorization, err := t.auth.authorization(req.Context())
+	if err != nil {
+		return errorResponse(req, http.StatusUnauthorized, err), nil
+	}
+
+	// Wait for a free slot so the provider stays below the API rate limits
+	select {
+	case t.limiter <- struct{}{}:
+	case <-req.Context().Done():
+		return errorResponse(req, http.StatusServiceUnavailable, req.Context().Err()), nil
+	}
+
+	release := func() {
+		<-t.limiter
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", authorization)
+
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	// The attempt holds its slot until the client has read the body
+	res.Body = &releaseOnCloseBody{ReadCloser: res.Body, release: release}
+
+	return res, nil
+}
+
+// isIdempotent reports whether repeating a request with the method has the
+// same effect as sending it once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// releaseOnCloseBody releases the resources of an attempt when its body is closed.
+type releaseOnCloseBody struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+// Close closes the body and releases the attempt.
+func (b *releaseOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+
+	return err
+}
+
+// annotateAttempts appends the number of attempts to the body of the final
+// failed response. The IotCentral client includes the body in its errors, so
+// the annotation reaches the diagnostics of every resource.
+func annotateAttempts(res *http.Response, attempts int) {
+	res.Body = &annotatedBody{
+		Reader: io.MultiReader(res.Body, strings.NewReader(fmt.Sprintf(" (gave up after %d attempts)", attempts))),
+		Closer: res.Body,
+	}
+	res.ContentLength = -1
+	res.Header.Del("Content-Length")
+}
+
+// annotatedBody is a response body with text appended to it.
+type annotatedBody struct {
+	io.Reader
+	io.Closer
+}
+
+// apiError formats a failed response like the IotCentral client does.
+func apiError(res *http.Response, body []byte) error {
+	return fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+}
+
+// isNotFound reports whether an IotCentral client error is a 404 response.
+func isNotFound(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), fmt.Sprintf("status: %d,", http.StatusNotFound))
+}
+
+// errorResponse wraps an error that occurred before a response was received.
+// The IotCentral client dereferences the response of failed requests, so
+// errors are reported through the status and body of a synthetic response.
+func errorResponse(req *http.Request, statusCode int, err error) *http.Response {
+	return &http.Response{
+		Status:     http.StatusText(statusCode),
+		StatusCode: statusCode,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{},
+		Body:       io.NopCloser(bytes.NewBufferString(err.Error())),
+		Request:    req,
+	}
+}
@@ -0,0 +1,263 @@
+package iotcentral
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAPITransportRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		statuses     []int
+		maxRetries   int
+		wantStatus   int
+		wantRequests int32
+		wantBody     string
+	}{
+		{name: "success", method: http.MethodGet, statuses: []int{200}, maxRetries: 3, wantStatus: 200, wantRequests: 1},
+		{name: "not retried", method: http.MethodGet, statuses: []int{404}, maxRetries: 3, wantStatus: 404, wantRequests: 1},
+		{name: "retried until success", method: http.MethodGet, statuses: []int{429, 503, 200}, maxRetries: 3, wantStatus: 200, wantRequests: 3},
+		{name: "body replayed", method: http.MethodPut, statuses: []int{500, 200}, maxRetries: 3, wantStatus: 200, wantRequests: 2},
+		{name: "gives up", method: http.MethodGet, statuses: []int{429}, maxRetries: 2, wantStatus: 429, wantRequests: 3, wantBody: "response 3 (gave up after 3 attempts)"},
+		{name: "post throttled", method: http.MethodPost, statuses: []int{429, 200}, maxRetries: 3, wantStatus: 200, wantRequests: 2},
+		{name: "post server error", method: http.MethodPost, statuses: []int{503, 200}, maxRetries: 3, wantStatus: 503, wantRequests: 1},
+		{name: "patch server error", method: http.MethodPatch, statuses: []int{500, 200}, maxRetries: 3, wantStatus: 500, wantRequests: 1},
+		{name: "retries disabled", method: http.MethodGet, statuses: []int{503}, maxRetries: 0, wantStatus: 503, wantRequests: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := atomic.AddInt32(&requests, 1)
+
+				if r.Header.Get("Authorization") != "SharedAccessSignature test" {
+					t.Errorf("request %d: missing authorization", n)
+				}
+
+				if body, _ := io.ReadAll(r.Body); string(body) != "payload" {
+					t.Errorf("request %d: body = %q, want %q", n, body, "payload")
+				}
+
+				status := tt.statuses[len(tt.statuses)-1]
+				if int(n) <= len(tt.statuses) {
+					status = tt.statuses[n-1]
+				}
+
+				w.WriteHeader(status)
+				fmt.Fprintf(w, "response %d", n)
+			}))
+			defer server.Close()
+
+			client := newHTTPClient(clientConfig{
+				Auth:    newAPITokenAuthorizer("test"),
+				Retry:   retryPolicy{MaxRetries: tt.maxRetries, MinDelay: time.Millisecond, MaxDelay: 2 * time.Millisecond},
+				Limiter: newRequestLimiter(1),
+			})
+
+			req, err := http.NewRequest(tt.method, server.URL, strings.NewReader("payload"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+
+			if res.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", res.StatusCode, tt.wantStatus)
+			}
+
+			if requests != tt.wantRequests {
+				t.Errorf("requests = %d, want %d", requests, tt.wantRequests)
+			}
+
+			// The body of the final attempt is passed on, annotated when retries are exhausted
+			want := tt.wantBody
+			if want == "" {
+				want = fmt.Sprintf("response %d", tt.wantRequests)
+			}
+
+			if string(body) != want {
+				t.Errorf("body = %q, want %q", body, want)
+			}
+		})
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		want     string
+		notFound bool
+	}{
+		{name: "bad request", status: 400, want: "status: 400, body: failed"},
+		{name: "not found", status: 404, want: "status: 404, body: failed", notFound: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &http.Response{StatusCode: tt.status, Header: http.Header{}}
+
+			err := apiError(res, []byte("failed"))
+			if err.Error() != tt.want {
+				t.Errorf("apiError() = %q, want %q", err, tt.want)
+			}
+
+			if isNotFound(err) != tt.notFound {
+				t.Errorf("isNotFound() = %v, want %v", isNotFound(err), tt.notFound)
+			}
+		})
+	}
+
+	if isNotFound(errors.New("status: 4040, body: x")) {
+		t.Error("isNotFound() matched a different status")
+	}
+}
+
+func TestAPITransportConnectionError(t *testing.T) {
+	tests := []struct {
+		method       string
+		wantRequests int32
+	}{
+		{method: http.MethodGet, wantRequests: 3},
+		{method: http.MethodPut, wantRequests: 3},
+		{method: http.MethodDelete, wantRequests: 3},
+		{method: http.MethodPost, wantRequests: 1},
+		{method: http.MethodPatch, wantRequests: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			var requests int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&requests, 1)
+
+				// Drop the connection without a response, as if it was reset
+				conn, _, err := w.(http.Hijacker).Hijack()
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				conn.Close()
+			}))
+			defer server.Close()
+
+			client := newHTTPClient(clientConfig{
+				Auth:    newAPITokenAuthorizer("test"),
+				Retry:   retryPolicy{MaxRetries: 2, MinDelay: time.Millisecond, MaxDelay: 2 * time.Millisecond},
+				Limiter: newRequestLimiter(1),
+			})
+
+			req, err := http.NewRequest(tt.method, server.URL, strings.NewReader("payload"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			res.Body.Close()
+
+			if res.StatusCode != http.StatusServiceUnavailable {
+				t.Errorf("status = %d, want %d", res.StatusCode, http.StatusServiceUnavailable)
+			}
+
+			if requests != tt.wantRequests {
+				t.Errorf("requests = %d, want %d", requests, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestAPITransportAttemptsInClientErrors(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, "unavailable")
+	}))
+	defer server.Close()
+
+	client := newClient(clientConfig{
+		Host:    server.URL,
+		Auth:    newAPITokenAuthorizer("test"),
+		Retry:   retryPolicy{MaxRetries: 2, MinDelay: time.Millisecond, MaxDelay: 2 * time.Millisecond},
+		Limiter: newRequestLimiter(1),
+	})
+
+	// Errors of the IotCentral client include the number of attempts as well
+	_, err := client.GetRole("operator")
+	if err == nil {
+		t.Fatal("GetRole() expected an error")
+	}
+
+	if want := "status: 503, body: unavailable (gave up after 3 attempts)"; err.Error() != want {
+		t.Errorf("GetRole() error = %q, want %q", err, want)
+	}
+
+	if requests != 3 {
+		t.Errorf("requests = %d, want %d", requests, 3)
+	}
+}
+
+func TestRequestLimiterShared(t *testing.T) {
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	limiter := newRequestLimiter(1)
+	first := newHTTPClient(clientConfig{Auth: newAPITokenAuthorizer("first"), Limiter: limiter})
+	second := newHTTPClient(clientConfig{Auth: newAPITokenAuthorizer("second"), Limiter: limiter})
+
+	go func() {
+		res, err := first.Get(server.URL)
+		if err == nil {
+			res.Body.Close()
+		}
+	}()
+	<-started
+
+	// The second client waits for the slot held by the first one
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := second.Do(req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want %d", res.StatusCode, http.StatusServiceUnavailable)
+	}
+
+	if len(started) != 0 {
+		t.Error("second client sent a request while the limit was reached")
+	}
+}
@@ -0,0 +1,161 @@
+package iotcentral
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+)
+
+// azureCredentialConfig holds the Azure authentication settings of the provider.
+type azureCredentialConfig struct {
+	TenantID                  string
+	ClientID                  string
+	ClientSecret              string
+	ClientCertificatePath     string
+	ClientCertificatePassword string
+	UseOIDC                   bool
+	OIDCTokenFilePath         string
+	UseMSI                    bool
+	UseCLI                    bool
+	AuthorityHost             string
+}
+
+// isExplicit reports whether any authentication method was configured.
+// Without one the provider falls back to the default Azure credential.
+func (c azureCredentialConfig) isExplicit() bool {
+	return c.ClientSecret != "" || c.ClientCertificatePath != "" || c.UseOIDC || c.UseMSI || c.UseCLI
+}
+
+// newAzureCredential creates a credential chain from the configured
+// authentication methods. Methods are tried in a fixed order: client secret,
+// client certificate, OIDC, managed identity and finally the Azure CLI.
+func newAzureCredential(c azureCredentialConfig) (azcore.TokenCredential, error) {
+	clientOptions := azcore.ClientOptions{
+		Cloud: cloud.Configuration{
+			ActiveDirectoryAuthorityHost: c.AuthorityHost,
+			Services:                     map[cloud.ServiceName]cloud.ServiceConfiguration{},
+		},
+	}
+
+	if !c.isExplicit() {
+		return azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{
+			ClientOptions: clientOptions,
+			TenantID:      c.TenantID,
+		})
+	}
+
+	var sources []azcore.TokenCredential
+
+	if c.ClientSecret != "" {
+		if err := c.requireServicePrincipal("client_secret"); err != nil {
+			return nil, err
+		}
+
+		cred, err := azidentity.NewClientSecretCredential(c.TenantID, c.ClientID, c.ClientSecret, &azidentity.ClientSecretCredentialOptions{
+			ClientOptions: clientOptions,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		sources = append(sources, cred)
+	}
+
+	if c.ClientCertificatePath != "" {
+		if err := c.requireServicePrincipal("client_certificate_path"); err != nil {
+			return nil, err
+		}
+
+		data, err := os.ReadFile(c.ClientCertificatePath)
+		if err != nil {
+			return nil, fmt.Errorf("reading client certificate: %w", err)
+		}
+
+		certs, key, err := azidentity.ParseCertificates(data, []byte(c.ClientCertificatePassword))
+		if err != nil {
+			return nil, fmt.Errorf("parsing client certificate: %w", err)
+		}
+
+		cred, err := azidentity.NewClientCertificateCredential(c.TenantID, c.ClientID, certs, key, &azidentity.ClientCertificateCredentialOptions{
+			ClientOptions: clientOptions,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		sources = append(sources, cred)
+	}
+
+	if c.UseOIDC {
+		if err := c.requireServicePrincipal("use_oidc"); err != nil {
+			return nil, err
+		}
+
+		if c.OIDCTokenFilePath == "" {
+			return nil, errors.New("oidc_token_file_path is required when use_oidc is enabled")
+		}
+
+		tokenFilePath := c.OIDCTokenFilePath
+		cred, err := azidentity.NewClientAssertionCredential(c.TenantID, c.ClientID, func(context.Context) (string, error) {
+			// The token file is read on every request as it is rotated by the platform
+			token, err := os.ReadFile(tokenFilePath)
+			if err != nil {
+				return "", fmt.Errorf("reading OIDC token file: %w", err)
+			}
+
+			return strings.TrimSpace(string(token)), nil
+		}, &azidentity.ClientAssertionCredentialOptions{
+			ClientOptions: clientOptions,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		sources = append(sources, cred)
+	}
+
+	if c.UseMSI {
+		options := &azidentity.ManagedIdentityCredentialOptions{
+			ClientOptions: clientOptions,
+		}
+		if c.ClientID != "" {
+			options.ID = azidentity.ClientID(c.ClientID)
+		}
+
+		cred, err := azidentity.NewManagedIdentityCredential(options)
+		if err != nil {
+			return nil, err
+		}
+
+		sources = append(sources, cred)
+	}
+
+	if c.UseCLI {
+		cred, err := azidentity.NewAzureCLICredential(&azidentity.AzureCLICredentialOptions{
+			TenantID: c.TenantID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		sources = append(sources, cred)
+	}
+
+	return azidentity.NewChainedTokenCredential(sources, nil)
+}
+
+// requireServicePrincipal checks that a tenant and client ID are available
+// for the given service principal authentication attribute.
+func (c azureCredentialConfig) requireServicePrincipal(attribute string) error {
+	if c.TenantID == "" || c.ClientID == "" {
+		return fmt.Errorf("tenant_id and client_id are required when %s is set", attribute)
+	}
+
+	return nil
+}
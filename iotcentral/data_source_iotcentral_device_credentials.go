@@ -0,0 +1,151 @@
+package iotcentral
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &deviceCredentialsDataSource{}
+	_ datasource.DataSourceWithConfigure = &deviceCredentialsDataSource{}
+)
+
+// NewDeviceCredentialsDataSource is a helper function to simplify the provider implementation.
+func NewDeviceCredentialsDataSource() datasource.DataSource {
+	return &deviceCredentialsDataSource{}
+}
+
+// deviceCredentialsDataSource is the data source implementation.
+type deviceCredentialsDataSource struct {
+	client *iotcentral.Client
+}
+
+// deviceCredentialsDataSourceModel maps the data source schema data.
+type deviceCredentialsDataSourceModel struct {
+	DeviceID                       types.String `tfsdk:"device_id"`
+	IDScope                        types.String `tfsdk:"id_scope"`
+	AttestationType                types.String `tfsdk:"attestation_type"`
+	PrimaryKey                     types.String `tfsdk:"primary_key"`
+	SecondaryKey                   types.String `tfsdk:"secondary_key"`
+	PrimaryCertificateThumbprint   types.String `tfsdk:"primary_certificate_thumbprint"`
+	SecondaryCertificateThumbprint types.String `tfsdk:"secondary_certificate_thumbprint"`
+	TPMEndorsementKey              types.String `tfsdk:"tpm_endorsement_key"`
+}
+
+// Metadata returns the data source type name.
+func (d *deviceCredentialsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_credentials"
+}
+
+// Schema defines the schema for the data source.
+func (d *deviceCredentialsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to access the credentials a device uses to provision itself through the Device Provisioning Service.",
+		Attributes: map[string]schema.Attribute{
+			"device_id": schema.StringAttribute{
+				Description: "Unique ID of the device.",
+				Required:    true,
+			},
+			"id_scope": schema.StringAttribute{
+				Description: "ID scope of the Device Provisioning Service of the application.",
+				Computed:    true,
+			},
+			"attestation_type": schema.StringAttribute{
+				Description: "Attestation type of the device, one of `symmetricKey`, `x509` or `tpm`.",
+				Computed:    true,
+			},
+			"primary_key": schema.StringAttribute{
+				Description: "Primary symmetric key of the device, when the attestation type is `symmetricKey`.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"secondary_key": schema.StringAttribute{
+				Description: "Secondary symmetric key of the device, when the attestation type is `symmetricKey`.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"primary_certificate_thumbprint": schema.StringAttribute{
+				Description: "SHA1 thumbprint of the primary client certificate, when the attestation type is `x509`.",
+				Computed:    true,
+			},
+			"secondary_certificate_thumbprint": schema.StringAttribute{
+				Description: "SHA1 thumbprint of the secondary client certificate, when the attestation type is `x509`.",
+				Computed:    true,
+			},
+			"tpm_endorsement_key": schema.StringAttribute{
+				Description: "Endorsement key of the TPM, when the attestation type is `tpm`.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *deviceCredentialsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*iotcentral.Client)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *deviceCredentialsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state deviceCredentialsDataSourceModel
+
+	// Read the config
+	var cfg deviceCredentialsDataSourceModel
+	diags := req.Config.Get(ctx, &cfg)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentials, err := getDeviceCredentials(ctx, d.client, cfg.DeviceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read IotCentral Device Credentials",
+			"Could not read credentials of IotCentral device ID "+cfg.DeviceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.DeviceID = cfg.DeviceID
+	state.IDScope = types.StringValue(credentials.IDScope)
+	state.AttestationType = types.StringNull()
+	state.PrimaryKey = types.StringNull()
+	state.SecondaryKey = types.StringNull()
+	state.PrimaryCertificateThumbprint = types.StringNull()
+	state.SecondaryCertificateThumbprint = types.StringNull()
+	state.TPMEndorsementKey = types.StringNull()
+
+	switch {
+	case credentials.SymmetricKey != nil:
+		state.AttestationType = types.StringValue("symmetricKey")
+		state.PrimaryKey = types.StringValue(credentials.SymmetricKey.PrimaryKey)
+		state.SecondaryKey = types.StringValue(credentials.SymmetricKey.SecondaryKey)
+	case credentials.X509 != nil:
+		state.AttestationType = types.StringValue("x509")
+		if primary := credentials.X509.ClientCertificates.Primary; primary != nil {
+			state.PrimaryCertificateThumbprint = types.StringValue(primary.Info.SHA1Thumbprint)
+		}
+		if secondary := credentials.X509.ClientCertificates.Secondary; secondary != nil {
+			state.SecondaryCertificateThumbprint = types.StringValue(secondary.Info.SHA1Thumbprint)
+		}
+	case credentials.TPM != nil:
+		state.AttestationType = types.StringValue("tpm")
+		state.TPMEndorsementKey = types.StringValue(credentials.TPM.EndorsementKey)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
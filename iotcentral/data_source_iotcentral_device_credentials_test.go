@@ -0,0 +1,35 @@
+package iotcentral
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIotCentralDeviceCredentialsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+				resource "iotcentral_device" "test" {
+					id = "testcredentialsdevice"
+					display_name = "Test credentials device"
+				}
+
+				data "iotcentral_device_credentials" "test" {
+					device_id = iotcentral_device.test.id
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the credentials of the device are returned
+					resource.TestCheckResourceAttr("data.iotcentral_device_credentials.test", "device_id", "testcredentialsdevice"),
+					resource.TestCheckResourceAttrSet("data.iotcentral_device_credentials.test", "id_scope"),
+					resource.TestCheckResourceAttr("data.iotcentral_device_credentials.test", "attestation_type", "symmetricKey"),
+					resource.TestCheckResourceAttrSet("data.iotcentral_device_credentials.test", "primary_key"),
+					resource.TestCheckResourceAttrSet("data.iotcentral_device_credentials.test", "secondary_key"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,175 @@
+package iotcentral
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &organizationDataSource{}
+	_ datasource.DataSourceWithConfigure      = &organizationDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &organizationDataSource{}
+)
+
+// NewOrganizationDataSource is a helper function to simplify the provider implementation.
+func NewOrganizationDataSource() datasource.DataSource {
+	return &organizationDataSource{}
+}
+
+// organizationDataSource is the data source implementation.
+type organizationDataSource struct {
+	client *iotcentral.Client
+}
+
+// organizationLookupDataSourceModel maps the data source schema data.
+type organizationLookupDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Parent      types.String `tfsdk:"parent"`
+}
+
+// Metadata returns the data source type name.
+func (d *organizationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+// Schema defines the schema for the data source.
+func (d *organizationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to access an existing organization by its ID or its unique display name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique ID of the organization. Exactly one of `id` or `display_name` must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"display_name": schema.StringAttribute{
+				Description: "Display name of the organization, which must match a single organization.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"parent": schema.StringAttribute{
+				Description: "ID of the parent of the organization, unset for top-level organizations.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig validates that the organization is looked up by one attribute.
+func (d *organizationDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var cfg organizationLookupDataSourceModel
+	diags := req.Config.Get(ctx, &cfg)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateExactlyOneAttribute(&resp.Diagnostics, map[string]types.String{"id": cfg.ID, "display_name": cfg.DisplayName})
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *organizationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*iotcentral.Client)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *organizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state organizationLookupDataSourceModel
+
+	// Read the config
+	var cfg organizationLookupDataSourceModel
+	diags := req.Config.Get(ctx, &cfg)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var organization *iotcentral.OrganizationResponse
+	if !cfg.ID.IsNull() {
+		var err error
+		organization, err = clientWithContext(ctx, d.client).GetOrganization(cfg.ID.ValueString())
+		if err != nil {
+			detail := err.Error()
+			if isNotFound(err) {
+				detail = "No organization found with ID " + cfg.ID.ValueString() + "."
+			}
+
+			resp.Diagnostics.AddError("Unable to Read IotCentral Organization", detail)
+			return
+		}
+	} else {
+		organizations, err := getOrganizations(ctx, d.client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read IotCentral Organization",
+				err.Error(),
+			)
+			return
+		}
+
+		var candidates []iotcentral.OrganizationResponse
+		for _, o := range organizations {
+			if o.DisplayName == cfg.DisplayName.ValueString() {
+				candidates = append(candidates, o)
+			}
+		}
+
+		switch len(candidates) {
+		case 0:
+			resp.Diagnostics.AddError(
+				"Unable to Read IotCentral Organization",
+				"No organization found with display name "+cfg.DisplayName.ValueString()+".",
+			)
+			return
+		case 1:
+			organization = &candidates[0]
+		default:
+			resp.Diagnostics.AddError(
+				"Unable to Read IotCentral Organization",
+				"Multiple organizations found with display name "+cfg.DisplayName.ValueString()+
+					", set id to one of: "+organizationCandidates(candidates)+".",
+			)
+			return
+		}
+	}
+
+	state.ID = types.StringValue(organization.ID)
+	state.DisplayName = types.StringValue(organization.DisplayName)
+	state.Parent = optionalStringValue(organization.Parent)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// organizationCandidates describes organizations with the same display name
+// by their ID and parent.
+func organizationCandidates(organizations []iotcentral.OrganizationResponse) string {
+	var candidates []string
+	for _, o := range organizations {
+		candidate := o.ID
+		if o.Parent != "" {
+			candidate += " (parent " + o.Parent + ")"
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	sort.Strings(candidates)
+	return strings.Join(candidates, ", ")
+}
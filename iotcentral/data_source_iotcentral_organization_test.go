@@ -0,0 +1,85 @@
+package iotcentral
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccOrganizationConfig creates two organizations with the same display
+// name under different parents.
+const testAccOrganizationConfig = `
+resource "iotcentral_organization" "north" {
+	id = "testorglookupnorth"
+	display_name = "Test lookup north"
+}
+
+resource "iotcentral_organization" "south" {
+	id = "testorglookupsouth"
+	display_name = "Test lookup south"
+}
+
+resource "iotcentral_organization" "north_plant" {
+	id = "testorglookupnorthplant"
+	display_name = "Test lookup plant"
+	parent = iotcentral_organization.north.id
+}
+
+resource "iotcentral_organization" "south_plant" {
+	id = "testorglookupsouthplant"
+	display_name = "Test lookup plant"
+	parent = iotcentral_organization.south.id
+}
+`
+
+func TestAccIotCentralOrganizationDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Lookup validation testing
+			{
+				Config: providerConfig + `
+				data "iotcentral_organization" "test" {
+					id = "testorglookupnorth"
+					display_name = "Test lookup north"
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Read by display_name testing
+			{
+				Config: providerConfig + testAccOrganizationConfig + `
+				data "iotcentral_organization" "test" {
+					display_name = iotcentral_organization.north.display_name
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the organization is found
+					resource.TestCheckResourceAttr("data.iotcentral_organization.test", "id", "testorglookupnorth"),
+					resource.TestCheckNoResourceAttr("data.iotcentral_organization.test", "parent"),
+				),
+			},
+			// Read by id testing
+			{
+				Config: providerConfig + testAccOrganizationConfig + `
+				data "iotcentral_organization" "test" {
+					id = iotcentral_organization.north_plant.id
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the organization is found
+					resource.TestCheckResourceAttr("data.iotcentral_organization.test", "display_name", "Test lookup plant"),
+					resource.TestCheckResourceAttr("data.iotcentral_organization.test", "parent", "testorglookupnorth"),
+				),
+			},
+			// Ambiguous display_name testing
+			{
+				Config: providerConfig + testAccOrganizationConfig + `
+				data "iotcentral_organization" "test" {
+					display_name = "Test lookup plant"
+
+					depends_on = [iotcentral_organization.north_plant, iotcentral_organization.south_plant]
+				}`,
+				ExpectError: regexp.MustCompile(`testorglookupnorthplant \(parent testorglookupnorth\), testorglookupsouthplant`),
+			},
+		},
+	})
+}
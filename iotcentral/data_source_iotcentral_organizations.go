@@ -0,0 +1,226 @@
+package iotcentral
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &organizationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &organizationsDataSource{}
+)
+
+// NewOrganizationsDataSource is a helper function to simplify the provider implementation.
+func NewOrganizationsDataSource() datasource.DataSource {
+	return &organizationsDataSource{}
+}
+
+// organizationsDataSource is the data source implementation.
+type organizationsDataSource struct {
+	client *iotcentral.Client
+}
+
+// organizationsDataSourceModel maps the data source schema data.
+type organizationsDataSourceModel struct {
+	Parent        types.String                  `tfsdk:"parent"`
+	Root          types.String                  `tfsdk:"root"`
+	Organizations []organizationDataSourceModel `tfsdk:"organizations"`
+}
+
+// organizationDataSourceModel maps the organization schema data.
+type organizationDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Parent      types.String `tfsdk:"parent"`
+	Depth       types.Int64  `tfsdk:"depth"`
+	Path        types.List   `tfsdk:"path"`
+}
+
+// Metadata returns the data source type name.
+func (d *organizationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organizations"
+}
+
+// Schema defines the schema for the data source.
+func (d *organizationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to access the organization tree of the application. " +
+			"Parents are listed before their children, and the filters can be combined.",
+		Attributes: map[string]schema.Attribute{
+			"parent": schema.StringAttribute{
+				Description: "Only return the direct children of the organization with this ID.",
+				Optional:    true,
+			},
+			"root": schema.StringAttribute{
+				Description: "Only return the organization with this ID and all organizations below it.",
+				Optional:    true,
+			},
+			"organizations": schema.ListNestedAttribute{
+				Description: "The organizations of the application.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Unique ID of the organization.",
+							Computed:    true,
+						},
+						"display_name": schema.StringAttribute{
+							Description: "Display name of the organization.",
+							Computed:    true,
+						},
+						"parent": schema.StringAttribute{
+							Description: "ID of the parent of the organization, unset for top-level organizations.",
+							Computed:    true,
+						},
+						"depth": schema.Int64Attribute{
+							Description: "Number of ancestors of the organization, `0` for top-level organizations.",
+							Computed:    true,
+						},
+						"path": schema.ListAttribute{
+							Description: "IDs of the ancestors of the organization, from the top-level organization down to its parent.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *organizationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*iotcentral.Client)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *organizationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state organizationsDataSourceModel
+
+	// Read the config
+	var cfg organizationsDataSourceModel
+	diags := req.Config.Get(ctx, &cfg)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizations, err := getOrganizations(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read IotCentral Organizations",
+			err.Error(),
+		)
+		return
+	}
+
+	byID := map[string]iotcentral.OrganizationResponse{}
+	for _, organization := range organizations {
+		byID[organization.ID] = organization
+	}
+
+	// Filters must name an existing organization, so typos do not silently return nothing
+	for name, filter := range map[string]types.String{"parent": cfg.Parent, "root": cfg.Root} {
+		if _, ok := byID[filter.ValueString()]; !filter.IsNull() && !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Unable to Read IotCentral Organizations",
+				"No organization found with ID "+filter.ValueString()+".",
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	paths := map[string][]string{}
+	sortKeys := map[string][]string{}
+	for _, organization := range organizations {
+		ancestors := organizationAncestors(byID, organization.ID)
+		paths[organization.ID] = ancestors
+		sortKeys[organization.ID] = append(append([]string{}, ancestors...), organization.ID)
+	}
+
+	// List parents before their children
+	sort.SliceStable(organizations, func(i, j int) bool {
+		a, b := sortKeys[organizations[i].ID], sortKeys[organizations[j].ID]
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+
+		return len(a) < len(b)
+	})
+
+	state.Parent = cfg.Parent
+	state.Root = cfg.Root
+	state.Organizations = []organizationDataSourceModel{}
+	for _, organization := range organizations {
+		ancestors := paths[organization.ID]
+
+		if !cfg.Parent.IsNull() && organization.Parent != cfg.Parent.ValueString() {
+			continue
+		}
+
+		if !cfg.Root.IsNull() && organization.ID != cfg.Root.ValueString() && !containsString(ancestors, cfg.Root.ValueString()) {
+			continue
+		}
+
+		ancestorIDs, diags := types.ListValueFrom(ctx, types.StringType, ancestors)
+		resp.Diagnostics.Append(diags...)
+
+		state.Organizations = append(state.Organizations, organizationDataSourceModel{
+			ID:          types.StringValue(organization.ID),
+			DisplayName: types.StringValue(organization.DisplayName),
+			Parent:      optionalStringValue(organization.Parent),
+			Depth:       types.Int64Value(int64(len(ancestors))),
+			Path:        ancestorIDs,
+		})
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// organizationAncestors returns the IDs of the ancestors of an organization,
+// from the top-level organization down to its parent.
+func organizationAncestors(byID map[string]iotcentral.OrganizationResponse, id string) []string {
+	ancestors := []string{}
+	seen := map[string]bool{id: true}
+	for parent := byID[id].Parent; parent != "" && !seen[parent]; parent = byID[parent].Parent {
+		seen[parent] = true
+		ancestors = append([]string{parent}, ancestors...)
+	}
+
+	return ancestors
+}
+
+// containsString reports whether a slice contains a string.
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
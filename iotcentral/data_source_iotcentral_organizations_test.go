@@ -0,0 +1,125 @@
+package iotcentral
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// testAccOrganizationsConfig creates a small organization tree.
+const testAccOrganizationsConfig = `
+resource "iotcentral_organization" "root" {
+	id = "testorgtreeroot"
+	display_name = "Test tree root"
+}
+
+resource "iotcentral_organization" "child" {
+	id = "testorgtreechild"
+	display_name = "Test tree child"
+	parent = iotcentral_organization.root.id
+}
+
+resource "iotcentral_organization" "grandchild" {
+	id = "testorgtreegrandchild"
+	display_name = "Test tree grandchild"
+	parent = iotcentral_organization.child.id
+}
+`
+
+func TestAccIotCentralOrganizationsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read subtree testing
+			{
+				Config: providerConfig + testAccOrganizationsConfig + `
+				data "iotcentral_organizations" "test" {
+					root = iotcentral_organization.root.id
+
+					depends_on = [iotcentral_organization.grandchild]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the subtree is returned with parents first
+					resource.TestCheckResourceAttr("data.iotcentral_organizations.test", "organizations.#", "3"),
+					resource.TestCheckResourceAttr("data.iotcentral_organizations.test", "organizations.0.id", "testorgtreeroot"),
+					resource.TestCheckResourceAttr("data.iotcentral_organizations.test", "organizations.0.depth", "0"),
+					resource.TestCheckNoResourceAttr("data.iotcentral_organizations.test", "organizations.0.parent"),
+					resource.TestCheckResourceAttr("data.iotcentral_organizations.test", "organizations.2.id", "testorgtreegrandchild"),
+					resource.TestCheckResourceAttr("data.iotcentral_organizations.test", "organizations.2.depth", "2"),
+					resource.TestCheckResourceAttr("data.iotcentral_organizations.test", "organizations.2.path.#", "2"),
+					resource.TestCheckResourceAttr("data.iotcentral_organizations.test", "organizations.2.path.0", "testorgtreeroot"),
+					resource.TestCheckResourceAttr("data.iotcentral_organizations.test", "organizations.2.path.1", "testorgtreechild"),
+				),
+			},
+			// Read children testing
+			{
+				Config: providerConfig + testAccOrganizationsConfig + `
+				data "iotcentral_organizations" "test" {
+					parent = iotcentral_organization.root.id
+
+					depends_on = [iotcentral_organization.grandchild]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify only the direct children are returned
+					resource.TestCheckResourceAttr("data.iotcentral_organizations.test", "organizations.#", "1"),
+					resource.TestCheckResourceAttr("data.iotcentral_organizations.test", "organizations.0.id", "testorgtreechild"),
+					resource.TestCheckResourceAttr("data.iotcentral_organizations.test", "organizations.0.parent", "testorgtreeroot"),
+				),
+			},
+		},
+	})
+}
+
+func TestOrganizationAncestors(t *testing.T) {
+	byID := map[string]iotcentral.OrganizationResponse{
+		"root":       {ID: "root"},
+		"child":      {ID: "child", Parent: "root"},
+		"grandchild": {ID: "grandchild", Parent: "child"},
+		"orphan":     {ID: "orphan", Parent: "missing"},
+		"cycle1":     {ID: "cycle1", Parent: "cycle2"},
+		"cycle2":     {ID: "cycle2", Parent: "cycle1"},
+	}
+
+	tests := []struct {
+		name string
+		id   string
+		want []string
+	}{
+		{name: "root", id: "root", want: []string{}},
+		{name: "child", id: "child", want: []string{"root"}},
+		{name: "grandchild", id: "grandchild", want: []string{"root", "child"}},
+		{name: "missing parent", id: "orphan", want: []string{"missing"}},
+		{name: "cycle", id: "cycle1", want: []string{"cycle2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := organizationAncestors(byID, tt.id); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("organizationAncestors(%q) = %v, want %v", tt.id, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContainsString(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		value  string
+		want   bool
+	}{
+		{name: "present", values: []string{"a", "b"}, value: "b", want: true},
+		{name: "absent", values: []string{"a", "b"}, value: "c", want: false},
+		{name: "empty", values: nil, value: "a", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := containsString(tt.values, tt.value); got != tt.want {
+				t.Errorf("containsString(%v, %q) = %v, want %v", tt.values, tt.value, got, tt.want)
+			}
+		})
+	}
+}
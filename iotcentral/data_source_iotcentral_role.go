@@ -2,6 +2,8 @@ package iotcentral
 
 import (
 	"context"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -11,10 +13,19 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &roleDataSource{}
-	_ datasource.DataSourceWithConfigure = &roleDataSource{}
+	_ datasource.DataSource                   = &roleDataSource{}
+	_ datasource.DataSourceWithConfigure      = &roleDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &roleDataSource{}
 )
 
+// roleNameAliases maps the portal names of built-in roles to their API names.
+var roleNameAliases = map[string]string{
+	"App Administrator": "Administrator",
+	"App Builder":       "Builder",
+	"App Operator":      "Operator",
+	"Org Administrator": "Org Admin",
+}
+
 // NewRoleDataSource is a helper function to simplify the provider implementation.
 func NewRoleDataSource() datasource.DataSource {
 	return &roleDataSource{}
@@ -27,8 +38,9 @@ type roleDataSource struct {
 
 // roleDataSourceModel maps the data source schema data.
 type roleDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	DisplayName types.String `tfsdk:"display_name"`
+	ID            types.String `tfsdk:"id"`
+	DisplayName   types.String `tfsdk:"display_name"`
+	CaseSensitive types.Bool   `tfsdk:"case_sensitive"`
 }
 
 // Metadata returns the data source type name.
@@ -39,19 +51,38 @@ func (d *roleDataSource) Metadata(_ context.Context, req datasource.MetadataRequ
 // Schema defines the schema for the data source.
 func (d *roleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Description: "Use this data source to access an existing role by its ID or its display name.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Unique ID of the role.",
+				Description: "Unique ID of the role. Exactly one of `id` or `display_name` must be set.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"display_name": schema.StringAttribute{
-				Description: "Display name of the role.",
-				Required:    true,
+				Description: "Display name of the role. The portal names `App Administrator`, `App Builder`, `App Operator` and `Org Administrator` are accepted as well.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"case_sensitive": schema.BoolAttribute{
+				Description: "Whether `display_name` must match the casing of the role name. Defaults to `false`.",
+				Optional:    true,
 			},
 		},
 	}
 }
 
+// ValidateConfig validates that the role is looked up by one attribute.
+func (d *roleDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var cfg roleDataSourceModel
+	diags := req.Config.Get(ctx, &cfg)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateExactlyOneAttribute(&resp.Diagnostics, map[string]types.String{"id": cfg.ID, "display_name": cfg.DisplayName})
+}
+
 // Configure adds the provider configured client to the data source.
 func (d *roleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -73,17 +104,53 @@ func (d *roleDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	role, err := d.client.GetRoleByName(cfg.DisplayName.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Read IotCentral Role",
-			err.Error(),
-		)
-		return
+	var role *iotcentral.RoleResponse
+	if !cfg.ID.IsNull() {
+		var err error
+		role, err = clientWithContext(ctx, d.client).GetRole(cfg.ID.ValueString())
+		if err != nil {
+			detail := err.Error()
+			if isNotFound(err) {
+				detail = "No role found with ID " + cfg.ID.ValueString() + "."
+			}
+
+			resp.Diagnostics.AddError("Unable to Read IotCentral Role", detail)
+			return
+		}
+	} else {
+		roles, err := clientWithContext(ctx, d.client).GetRoles()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read IotCentral Role",
+				err.Error(),
+			)
+			return
+		}
+
+		candidates := findRolesByName(roles, cfg.DisplayName.ValueString(), cfg.CaseSensitive.ValueBool())
+
+		switch len(candidates) {
+		case 0:
+			resp.Diagnostics.AddError(
+				"Unable to Read IotCentral Role",
+				"No role found with display name "+cfg.DisplayName.ValueString()+", available roles: "+roleNames(roles)+".",
+			)
+			return
+		case 1:
+			role = &candidates[0]
+		default:
+			resp.Diagnostics.AddError(
+				"Unable to Read IotCentral Role",
+				"Multiple roles found with display name "+cfg.DisplayName.ValueString()+
+					", set case_sensitive or id to one of: "+roleCandidates(candidates)+".",
+			)
+			return
+		}
 	}
 
 	state.ID = types.StringValue(role.ID)
 	state.DisplayName = types.StringValue(role.DisplayName)
+	state.CaseSensitive = cfg.CaseSensitive
 
 	// Set state
 	diags = resp.State.Set(ctx, &state)
@@ -92,3 +159,56 @@ func (d *roleDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 }
+
+// findRolesByName returns the roles with the display name. Unless the match
+// is case sensitive, names differing only by casing match as well, and an
+// exact match is preferred when there are several. The portal names of
+// built-in roles match when no role has the name.
+func findRolesByName(roles []iotcentral.RoleResponse, name string, caseSensitive bool) []iotcentral.RoleResponse {
+	var candidates []iotcentral.RoleResponse
+	for _, r := range roles {
+		if r.DisplayName == name || (!caseSensitive && strings.EqualFold(r.DisplayName, name)) {
+			candidates = append(candidates, r)
+		}
+	}
+
+	if len(candidates) == 0 {
+		for alias, apiName := range roleNameAliases {
+			if alias == name || (!caseSensitive && strings.EqualFold(alias, name)) {
+				return findRolesByName(roles, apiName, true)
+			}
+		}
+	}
+
+	if len(candidates) > 1 {
+		for _, r := range candidates {
+			if r.DisplayName == name {
+				return []iotcentral.RoleResponse{r}
+			}
+		}
+	}
+
+	return candidates
+}
+
+// roleNames lists the display names of roles in alphabetical order.
+func roleNames(roles []iotcentral.RoleResponse) string {
+	var names []string
+	for _, r := range roles {
+		names = append(names, r.DisplayName)
+	}
+
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// roleCandidates describes roles with the same display name by their ID.
+func roleCandidates(roles []iotcentral.RoleResponse) string {
+	var candidates []string
+	for _, r := range roles {
+		candidates = append(candidates, r.ID+" ("+r.DisplayName+")")
+	}
+
+	sort.Strings(candidates)
+	return strings.Join(candidates, ", ")
+}
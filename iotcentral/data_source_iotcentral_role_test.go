@@ -1,9 +1,12 @@
 package iotcentral
 
 import (
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
 )
 
 func TestAccCoffeesDataSource(t *testing.T) {
@@ -54,3 +57,84 @@ func TestAccCoffeesDataSource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccIotCentralRoleDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+				data "iotcentral_role" "test" {
+					display_name = "org operator"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify names match regardless of casing
+					resource.TestCheckResourceAttr("data.iotcentral_role.test", "display_name", "Org Operator"),
+				),
+			},
+			{
+				Config: providerConfig + `
+				data "iotcentral_role" "test" {
+					display_name   = "org operator"
+					case_sensitive = true
+				}`,
+				ExpectError: regexp.MustCompile("available roles"),
+			},
+			{
+				Config: providerConfig + `
+				data "iotcentral_role" "test" {
+					display_name = "Operator"
+				}
+
+				data "iotcentral_role" "by_id" {
+					id = data.iotcentral_role.test.id
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify role looked up by ID
+					resource.TestCheckResourceAttr("data.iotcentral_role.by_id", "display_name", "Operator"),
+				),
+			},
+		},
+	})
+}
+
+func TestFindRolesByName(t *testing.T) {
+	roles := []iotcentral.RoleResponse{
+		{ID: "1", DisplayName: "Operator"},
+		{ID: "2", DisplayName: "Org Operator"},
+		{ID: "3", DisplayName: "Custom"},
+		{ID: "4", DisplayName: "custom"},
+	}
+
+	tests := []struct {
+		name          string
+		displayName   string
+		caseSensitive bool
+		want          []string
+	}{
+		{name: "exact", displayName: "Operator", want: []string{"1"}},
+		{name: "casing", displayName: "org operator", want: []string{"2"}},
+		{name: "case sensitive", displayName: "org operator", caseSensitive: true, want: nil},
+		{name: "prefers exact", displayName: "custom", want: []string{"4"}},
+		{name: "ambiguous", displayName: "CUSTOM", want: []string{"3", "4"}},
+		{name: "portal name", displayName: "App Operator", want: []string{"1"}},
+		{name: "portal name casing", displayName: "app operator", want: []string{"1"}},
+		{name: "portal name case sensitive", displayName: "app operator", caseSensitive: true, want: nil},
+		{name: "portal name of missing role", displayName: "App Builder", want: nil},
+		{name: "missing", displayName: "Viewer", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, r := range findRolesByName(roles, tt.displayName, tt.caseSensitive) {
+				got = append(got, r.ID)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("findRolesByName(%q, %v) = %v, want %v", tt.displayName, tt.caseSensitive, got, tt.want)
+			}
+		})
+	}
+}
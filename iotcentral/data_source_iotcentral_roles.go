@@ -0,0 +1,168 @@
+package iotcentral
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// builtInRoles maps the display names of the built-in roles to whether they
+// are scoped to organizations. The API does not expose the scope of roles.
+var builtInRoles = map[string]bool{
+	"Administrator": false,
+	"Builder":       false,
+	"Operator":      false,
+	"Org Admin":     true,
+	"Org Operator":  true,
+	"Org Viewer":    true,
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &rolesDataSource{}
+	_ datasource.DataSourceWithConfigure = &rolesDataSource{}
+)
+
+// NewRolesDataSource is a helper function to simplify the provider implementation.
+func NewRolesDataSource() datasource.DataSource {
+	return &rolesDataSource{}
+}
+
+// rolesDataSource is the data source implementation.
+type rolesDataSource struct {
+	client *iotcentral.Client
+}
+
+// rolesDataSourceModel maps the data source schema data.
+type rolesDataSourceModel struct {
+	Roles  []rolesItemModel `tfsdk:"roles"`
+	ByName types.Map        `tfsdk:"by_name"`
+}
+
+// rolesItemModel maps the role schema data.
+type rolesItemModel struct {
+	ID                 types.String `tfsdk:"id"`
+	DisplayName        types.String `tfsdk:"display_name"`
+	BuiltIn            types.Bool   `tfsdk:"built_in"`
+	OrganizationScoped types.Bool   `tfsdk:"organization_scoped"`
+}
+
+// Metadata returns the data source type name.
+func (d *rolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+// Schema defines the schema for the data source.
+func (d *rolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to access all built-in and custom roles of the application.",
+		Attributes: map[string]schema.Attribute{
+			"roles": schema.ListNestedAttribute{
+				Description: "The roles of the application, ordered by display name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Unique ID of the role.",
+							Computed:    true,
+						},
+						"display_name": schema.StringAttribute{
+							Description: "Display name of the role.",
+							Computed:    true,
+						},
+						"built_in": schema.BoolAttribute{
+							Description: "Whether the role is one of the built-in roles of IotCentral.",
+							Computed:    true,
+						},
+						"organization_scoped": schema.BoolAttribute{
+							Description: "Whether the role is assigned within an organization. Unset for custom roles, whose scope the API does not expose.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"by_name": schema.MapAttribute{
+				Description: "Map of role display names to role IDs. When several roles share a display name, " +
+					"only the first one listed in `roles` is included and a warning is reported.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *rolesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*iotcentral.Client)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *rolesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state rolesDataSourceModel
+
+	roles, err := clientWithContext(ctx, d.client).GetRoles()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read IotCentral Roles",
+			err.Error(),
+		)
+		return
+	}
+
+	sort.SliceStable(roles, func(i, j int) bool {
+		return roles[i].DisplayName < roles[j].DisplayName
+	})
+
+	byName := map[string]string{}
+	state.Roles = []rolesItemModel{}
+	for _, role := range roles {
+		organizationScoped, builtIn := builtInRoles[role.DisplayName]
+
+		item := rolesItemModel{
+			ID:                 types.StringValue(role.ID),
+			DisplayName:        types.StringValue(role.DisplayName),
+			BuiltIn:            types.BoolValue(builtIn),
+			OrganizationScoped: types.BoolNull(),
+		}
+
+		if builtIn {
+			item.OrganizationScoped = types.BoolValue(organizationScoped)
+		}
+
+		state.Roles = append(state.Roles, item)
+
+		if id, ok := byName[role.DisplayName]; ok {
+			resp.Diagnostics.AddWarning(
+				"Duplicate IotCentral Role Display Name",
+				"Roles "+id+" and "+role.ID+" share the display name "+role.DisplayName+", by_name only includes role "+id+". "+
+					"Use the roles attribute to look up the other role.",
+			)
+			continue
+		}
+
+		byName[role.DisplayName] = role.ID
+	}
+
+	byNameValue, diags := types.MapValueFrom(ctx, types.StringType, byName)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ByName = byNameValue
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
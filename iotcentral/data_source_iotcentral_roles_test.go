@@ -0,0 +1,39 @@
+package iotcentral
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIotCentralRolesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+				data "iotcentral_roles" "test" {}
+
+				data "iotcentral_role" "test" {
+					display_name = "Operator"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the built-in roles are returned
+					resource.TestCheckTypeSetElemNestedAttrs("data.iotcentral_roles.test", "roles.*", map[string]string{
+						"display_name":        "Administrator",
+						"built_in":            "true",
+						"organization_scoped": "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.iotcentral_roles.test", "roles.*", map[string]string{
+						"display_name":        "Org Admin",
+						"built_in":            "true",
+						"organization_scoped": "true",
+					}),
+					// Verify by_name matches the role data source
+					resource.TestCheckResourceAttrPair("data.iotcentral_roles.test", "by_name.Operator", "data.iotcentral_role.test", "id"),
+				),
+			},
+		},
+	})
+}
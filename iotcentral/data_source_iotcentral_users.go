@@ -0,0 +1,216 @@
+package iotcentral
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+// NewUsersDataSource is a helper function to simplify the provider implementation.
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+// usersDataSource is the data source implementation.
+type usersDataSource struct {
+	client *iotcentral.Client
+}
+
+// usersDataSourceModel maps the data source schema data.
+type usersDataSourceModel struct {
+	Type         types.String          `tfsdk:"type"`
+	Role         types.String          `tfsdk:"role"`
+	Organization types.String          `tfsdk:"organization"`
+	Users        []userDataSourceModel `tfsdk:"users"`
+}
+
+// userDataSourceModel maps the user schema data.
+type userDataSourceModel struct {
+	ID       types.String                  `tfsdk:"id"`
+	Type     types.String                  `tfsdk:"type"`
+	Email    types.String                  `tfsdk:"email"`
+	ObjectID types.String                  `tfsdk:"object_id"`
+	TenantID types.String                  `tfsdk:"tenant_id"`
+	Roles    []roleAssignmentResourceModel `tfsdk:"roles"`
+}
+
+// Metadata returns the data source type name.
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+// Schema defines the schema for the data source.
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to access all users of the application, including users not managed by Terraform. " +
+			"The filters can be combined, and `role` and `organization` must then match the same role assignment.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "Only return users of this type, one of `email`, `adGroup` or `servicePrincipal`.",
+				Optional:    true,
+				Validators: []validator.String{
+					oneOfValidator{values: userTypes},
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "Only return users with a role assignment for the role with this ID.",
+				Optional:    true,
+			},
+			"organization": schema.StringAttribute{
+				Description: "Only return users with a role assignment in the organization with this ID.",
+				Optional:    true,
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "The users of the application, ordered by ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Unique ID of the user.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the user, one of `email`, `adGroup` or `servicePrincipal`.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "Email address of the user, set for `email` users.",
+							Computed:    true,
+						},
+						"object_id": schema.StringAttribute{
+							Description: "The AAD object ID of the AD group or service principal.",
+							Computed:    true,
+						},
+						"tenant_id": schema.StringAttribute{
+							Description: "The AAD tenant ID of the AD group or service principal.",
+							Computed:    true,
+						},
+						"roles": schema.ListNestedAttribute{
+							Description: "List of role assignments that specify the permissions to access the application.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"role": schema.StringAttribute{
+										Description: "ID of the role for this role assignment.",
+										Computed:    true,
+									},
+									"organization": schema.StringAttribute{
+										Description: "ID of the organization for this role assignment.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*iotcentral.Client)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state usersDataSourceModel
+
+	// Read the config
+	var cfg usersDataSourceModel
+	diags := req.Config.Get(ctx, &cfg)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := getUsers(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read IotCentral Users",
+			err.Error(),
+		)
+		return
+	}
+
+	sort.SliceStable(users, func(i, j int) bool {
+		return users[i].ID < users[j].ID
+	})
+
+	state.Type = cfg.Type
+	state.Role = cfg.Role
+	state.Organization = cfg.Organization
+	state.Users = []userDataSourceModel{}
+	for _, u := range users {
+		if !cfg.Type.IsNull() && u.Type != cfg.Type.ValueString() {
+			continue
+		}
+
+		if !userHasRoleAssignment(u, cfg.Role, cfg.Organization) {
+			continue
+		}
+
+		item := userDataSourceModel{
+			ID:       types.StringValue(u.ID),
+			Type:     types.StringValue(u.Type),
+			Email:    optionalStringValue(u.Email),
+			ObjectID: optionalStringValue(u.ObjectID),
+			TenantID: optionalStringValue(u.TenantID),
+			Roles:    []roleAssignmentResourceModel{},
+		}
+
+		for _, role := range u.Roles {
+			item.Roles = append(item.Roles, roleAssignmentResourceModel{
+				Role:         types.StringValue(role.Role),
+				Organization: optionalStringValue(role.Organization),
+			})
+		}
+
+		state.Users = append(state.Users, item)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// userHasRoleAssignment reports whether a user has a role assignment matching
+// both the role and the organization filter. Null filters match any value.
+func userHasRoleAssignment(u user, role types.String, organization types.String) bool {
+	if role.IsNull() && organization.IsNull() {
+		return true
+	}
+
+	for _, assignment := range u.Roles {
+		if !role.IsNull() && assignment.Role != role.ValueString() {
+			continue
+		}
+
+		if !organization.IsNull() && assignment.Organization != organization.ValueString() {
+			continue
+		}
+
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,88 @@
+package iotcentral
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+func TestAccIotCentralUsersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read filtered testing
+			{
+				Config: providerConfig + `
+				data "iotcentral_role" "test" {
+					display_name = "Org Operator"
+				}
+
+				resource "iotcentral_organization" "test" {
+					id = "testusersorg"
+					display_name = "Test Users Org"
+				}
+
+				resource "iotcentral_user" "test" {
+					email = "iotcentral.test.users@justbeawesome.net"
+					roles = [
+					  {
+						role = data.iotcentral_role.test.id
+						organization = iotcentral_organization.test.id
+					  }
+					]
+				}
+
+				data "iotcentral_users" "test" {
+					type = "email"
+					role = data.iotcentral_role.test.id
+					organization = iotcentral_organization.test.id
+
+					depends_on = [iotcentral_user.test]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify only the matching user is returned
+					resource.TestCheckResourceAttr("data.iotcentral_users.test", "users.#", "1"),
+					resource.TestCheckResourceAttrPair("data.iotcentral_users.test", "users.0.id", "iotcentral_user.test", "id"),
+					resource.TestCheckResourceAttr("data.iotcentral_users.test", "users.0.type", "email"),
+					resource.TestCheckResourceAttr("data.iotcentral_users.test", "users.0.email", "iotcentral.test.users@justbeawesome.net"),
+					resource.TestCheckResourceAttr("data.iotcentral_users.test", "users.0.roles.0.organization", "testusersorg"),
+				),
+			},
+		},
+	})
+}
+
+func TestUserHasRoleAssignment(t *testing.T) {
+	u := user{
+		ID: "user1",
+		Roles: []iotcentral.RoleAssignment{
+			{Role: "operator"},
+			{Role: "orgviewer", Organization: "org1"},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		role         types.String
+		organization types.String
+		want         bool
+	}{
+		{name: "no filters", role: types.StringNull(), organization: types.StringNull(), want: true},
+		{name: "role", role: types.StringValue("operator"), organization: types.StringNull(), want: true},
+		{name: "organization", role: types.StringNull(), organization: types.StringValue("org1"), want: true},
+		{name: "same assignment", role: types.StringValue("orgviewer"), organization: types.StringValue("org1"), want: true},
+		{name: "different assignments", role: types.StringValue("operator"), organization: types.StringValue("org1"), want: false},
+		{name: "other role", role: types.StringValue("builder"), organization: types.StringNull(), want: false},
+		{name: "other organization", role: types.StringNull(), organization: types.StringValue("org2"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := userHasRoleAssignment(u, tt.role, tt.organization); got != tt.want {
+				t.Errorf("userHasRoleAssignment(%s, %s) = %v, want %v", tt.role, tt.organization, got, tt.want)
+			}
+		})
+	}
+}
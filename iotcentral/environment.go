@@ -0,0 +1,113 @@
+package iotcentral
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// customEnvironment is the environment name for clouds that are not built in.
+const customEnvironment = "custom"
+
+// cloudEnvironment holds the IoT Central endpoints of an Azure cloud.
+type cloudEnvironment struct {
+	// TokenScope is the scope requested for IoT Central access tokens.
+	TokenScope string
+	// AuthorityHost is the base URL of the cloud's Azure Active Directory.
+	AuthorityHost string
+	// Domain is the domain that hosts the IoT Central applications.
+	Domain string
+	// ResourceManagerEndpoint is the Azure Resource Manager URL used to
+	// look up applications by their application ID.
+	ResourceManagerEndpoint string
+}
+
+// cloudEnvironments are the environments that can be selected with the
+// environment provider attribute.
+var cloudEnvironments = map[string]cloudEnvironment{
+	"public": {
+		TokenScope:              "https://apps.azureiotcentral.com/.default",
+		AuthorityHost:           "https://login.microsoftonline.com/",
+		Domain:                  "azureiotcentral.com",
+		ResourceManagerEndpoint: "https://management.azure.com",
+	},
+	"china": {
+		TokenScope:              "https://apps.azureiotcentral.cn/.default",
+		AuthorityHost:           "https://login.chinacloudapi.cn/",
+		Domain:                  "azureiotcentral.cn",
+		ResourceManagerEndpoint: "https://management.chinacloudapi.cn",
+	},
+	"usgovernment": {
+		TokenScope:              "https://apps.azureiotcentral.us/.default",
+		AuthorityHost:           "https://login.microsoftonline.us/",
+		Domain:                  "azureiotcentral.us",
+		ResourceManagerEndpoint: "https://management.usgovcloudapi.net",
+	},
+	customEnvironment: {},
+}
+
+// environmentNames returns the sorted names of the supported environments.
+func environmentNames() []string {
+	names := make([]string, 0, len(cloudEnvironments))
+	for name := range cloudEnvironments {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// lookupEnvironment returns the named environment with the token scope and
+// authority host overridden when set.
+func lookupEnvironment(name, tokenScope, authorityHost string) (cloudEnvironment, error) {
+	env, ok := cloudEnvironments[strings.ToLower(name)]
+	if !ok {
+		return cloudEnvironment{}, fmt.Errorf("unknown environment %q, expected one of: %s", name, strings.Join(environmentNames(), ", "))
+	}
+
+	if tokenScope != "" {
+		env.TokenScope = tokenScope
+	}
+
+	if authorityHost != "" {
+		env.AuthorityHost = authorityHost
+	}
+
+	if env.TokenScope == "" || env.AuthorityHost == "" {
+		return cloudEnvironment{}, fmt.Errorf("token_scope and authority_host are required for the %s environment", customEnvironment)
+	}
+
+	return env, nil
+}
+
+// validateHost checks that the host is an application URL of the
+// environment's domain. Custom environments accept any domain.
+func (e cloudEnvironment) validateHost(host string) error {
+	u, err := url.Parse(host)
+	if err != nil {
+		return err
+	}
+
+	if e.Domain == "" {
+		return nil
+	}
+
+	hostname := strings.ToLower(u.Hostname())
+	subdomain := strings.TrimSuffix(hostname, "."+e.Domain)
+	if subdomain == hostname || subdomain == "" || strings.Contains(subdomain, ".") {
+		return fmt.Errorf("host %q is not a https://<subdomain>.%s application URL", host, e.Domain)
+	}
+
+	return nil
+}
+
+// applicationHost returns the application URL for a subdomain.
+func (e cloudEnvironment) applicationHost(subdomain string) (string, error) {
+	if e.Domain == "" {
+		return "", fmt.Errorf("application subdomains are not supported for the %s environment, set host instead", customEnvironment)
+	}
+
+	return "https://" + strings.ToLower(subdomain) + "." + e.Domain, nil
+}
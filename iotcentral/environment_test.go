@@ -0,0 +1,77 @@
+package iotcentral
+
+import "testing"
+
+func TestLookupEnvironment(t *testing.T) {
+	tests := []struct {
+		name          string
+		environment   string
+		tokenScope    string
+		authorityHost string
+		wantScope     string
+		wantErr       bool
+	}{
+		{name: "public", environment: "public", wantScope: "https://apps.azureiotcentral.com/.default"},
+		{name: "case insensitive", environment: "China", wantScope: "https://apps.azureiotcentral.cn/.default"},
+		{name: "scope override", environment: "public", tokenScope: "https://example.com/.default", wantScope: "https://example.com/.default"},
+		{name: "unknown", environment: "moon", wantErr: true},
+		{name: "custom without endpoints", environment: "custom", wantErr: true},
+		{name: "custom", environment: "custom", tokenScope: "https://example.com/.default", authorityHost: "https://login.example.com/", wantScope: "https://example.com/.default"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env, err := lookupEnvironment(tt.environment, tt.tokenScope, tt.authorityHost)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("lookupEnvironment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err == nil && env.TokenScope != tt.wantScope {
+				t.Errorf("lookupEnvironment() token scope = %q, want %q", env.TokenScope, tt.wantScope)
+			}
+		})
+	}
+}
+
+func TestCloudEnvironmentValidateHost(t *testing.T) {
+	public := cloudEnvironments["public"]
+
+	tests := []struct {
+		name    string
+		env     cloudEnvironment
+		host    string
+		wantErr bool
+	}{
+		{name: "application", env: public, host: "https://myapp.azureiotcentral.com"},
+		{name: "upper case", env: public, host: "https://MyApp.AzureIotCentral.com"},
+		{name: "other cloud", env: public, host: "https://myapp.azureiotcentral.cn", wantErr: true},
+		{name: "domain only", env: public, host: "https://azureiotcentral.com", wantErr: true},
+		{name: "nested subdomain", env: public, host: "https://a.b.azureiotcentral.com", wantErr: true},
+		{name: "suffix lookalike", env: public, host: "https://myappazureiotcentral.com", wantErr: true},
+		{name: "custom", env: cloudEnvironments[customEnvironment], host: "https://iot.example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.env.validateHost(tt.host)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateHost(%q) error = %v, wantErr %v", tt.host, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCloudEnvironmentApplicationHost(t *testing.T) {
+	host, err := cloudEnvironments["usgovernment"].applicationHost("MyApp")
+	if err != nil {
+		t.Fatalf("applicationHost() error = %v", err)
+	}
+
+	if want := "https://myapp.azureiotcentral.us"; host != want {
+		t.Errorf("applicationHost() = %q, want %q", host, want)
+	}
+
+	if _, err := cloudEnvironments[customEnvironment].applicationHost("myapp"); err == nil {
+		t.Error("applicationHost() expected an error for the custom environment")
+	}
+}
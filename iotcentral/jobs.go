@@ -0,0 +1,286 @@
+package iotcentral
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// jobBatchModel maps job batch schema data.
+type jobBatchModel struct {
+	Type  types.String `tfsdk:"type"`
+	Value types.Int64  `tfsdk:"value"`
+}
+
+// jobCancellationThresholdModel maps job cancellation threshold schema data.
+type jobCancellationThresholdModel struct {
+	Type  types.String `tfsdk:"type"`
+	Value types.Int64  `tfsdk:"value"`
+	Batch types.Bool   `tfsdk:"batch"`
+}
+
+// jobDataModel maps job data schema data.
+type jobDataModel struct {
+	Type   types.String `tfsdk:"type"`
+	Target types.String `tfsdk:"target"`
+	Path   types.String `tfsdk:"path"`
+	Value  types.String `tfsdk:"value"`
+}
+
+// jobAttributes returns the schema attributes shared by jobs and scheduled
+// jobs. Jobs cannot be updated, so their attributes require replacement.
+func jobAttributes(kind string, requiresReplace bool) map[string]schema.Attribute {
+	var stringModifiers []planmodifier.String
+	var listModifiers []planmodifier.List
+	var objectModifiers []planmodifier.Object
+	if requiresReplace {
+		stringModifiers = []planmodifier.String{stringplanmodifier.RequiresReplace()}
+		listModifiers = []planmodifier.List{listplanmodifier.RequiresReplace()}
+		objectModifiers = []planmodifier.Object{objectplanmodifier.RequiresReplace()}
+	}
+
+	countTypes := []validator.String{
+		oneOfValidator{values: []string{"number", "percentage"}},
+	}
+
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Unique ID of the " + kind + ".",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"display_name": schema.StringAttribute{
+			Description:   "Display name of the " + kind + ".",
+			Required:      true,
+			PlanModifiers: stringModifiers,
+		},
+		"description": schema.StringAttribute{
+			Description:   "Detailed description of the " + kind + ".",
+			Optional:      true,
+			PlanModifiers: stringModifiers,
+		},
+		"group": schema.StringAttribute{
+			Description:   "ID of the device group of the devices the " + kind + " runs on.",
+			Required:      true,
+			PlanModifiers: stringModifiers,
+		},
+		"batch": schema.SingleNestedAttribute{
+			Description: "Updates the devices in batches instead of all at once.",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Description: "Whether the value is a `number` of devices or a `percentage` of the device group.",
+					Required:    true,
+					Validators:  countTypes,
+				},
+				"value": schema.Int64Attribute{
+					Description: "Number or percentage of devices in a batch.",
+					Required:    true,
+				},
+			},
+			PlanModifiers: objectModifiers,
+		},
+		"cancellation_threshold": schema.SingleNestedAttribute{
+			Description: "Cancels the " + kind + " when the number of device failures reaches the threshold.",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Description: "Whether the value is a `number` of devices or a `percentage` of the devices.",
+					Required:    true,
+					Validators:  countTypes,
+				},
+				"value": schema.Int64Attribute{
+					Description: "Number or percentage of failed devices that cancels the " + kind + ".",
+					Required:    true,
+				},
+				"batch": schema.BoolAttribute{
+					Description: "Whether the threshold applies to each batch instead of the whole " + kind + ".",
+					Optional:    true,
+				},
+			},
+			PlanModifiers: objectModifiers,
+		},
+		"data": schema.ListNestedAttribute{
+			Description: "Operations the " + kind + " runs on every device, in order.",
+			Required:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "Type of the operation, `property` to set a writable property, `cloudProperty` to set " +
+							"a cloud property or `command` to call a command.",
+						Required: true,
+						Validators: []validator.String{
+							oneOfValidator{values: []string{"property", "cloudProperty", "command"}},
+						},
+					},
+					"target": schema.StringAttribute{
+						Description: "ID of the device template that defines the property or command.",
+						Required:    true,
+					},
+					"path": schema.StringAttribute{
+						Description: "Name of the property or command, prefixed by the component name for components, such as `thermostat1/targetTemperature`.",
+						Required:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "JSON encoded value of the property or payload of the command, such as `jsonencode(21.5)`.",
+						Optional:    true,
+						Validators: []validator.String{
+							jsonValidator{anyValue: true},
+						},
+						PlanModifiers: []planmodifier.String{
+							suppressEquivalentJSON(),
+						},
+					},
+				},
+			},
+			PlanModifiers: listModifiers,
+		},
+		"organizations": schema.ListAttribute{
+			Description:   "List of IDs of the organizations the " + kind + " is available to.",
+			ElementType:   types.StringType,
+			Optional:      true,
+			PlanModifiers: listModifiers,
+		},
+	}
+}
+
+// jobBatchToAPI generates the API request body of a job batch.
+func jobBatchToAPI(m *jobBatchModel) *jobBatch {
+	if m == nil {
+		return nil
+	}
+
+	return &jobBatch{
+		Type:  m.Type.ValueString(),
+		Value: m.Value.ValueInt64(),
+	}
+}
+
+// jobBatchFromAPI maps a job batch received from the API to the model.
+func jobBatchFromAPI(batch *jobBatch) *jobBatchModel {
+	if batch == nil {
+		return nil
+	}
+
+	return &jobBatchModel{
+		Type:  types.StringValue(batch.Type),
+		Value: types.Int64Value(batch.Value),
+	}
+}
+
+// jobCancellationThresholdToAPI generates the API request body of a job
+// cancellation threshold.
+func jobCancellationThresholdToAPI(m *jobCancellationThresholdModel) *jobCancellationThreshold {
+	if m == nil {
+		return nil
+	}
+
+	return &jobCancellationThreshold{
+		Type:  m.Type.ValueString(),
+		Value: m.Value.ValueInt64(),
+		Batch: m.Batch.ValueBool(),
+	}
+}
+
+// jobCancellationThresholdFromAPI maps a job cancellation threshold received
+// from the API to the model. An unset batch flag stays unset when it is false.
+func jobCancellationThresholdFromAPI(prior *jobCancellationThresholdModel, threshold *jobCancellationThreshold) *jobCancellationThresholdModel {
+	if threshold == nil {
+		return nil
+	}
+
+	m := &jobCancellationThresholdModel{
+		Type:  types.StringValue(threshold.Type),
+		Value: types.Int64Value(threshold.Value),
+		Batch: types.BoolValue(threshold.Batch),
+	}
+
+	if !threshold.Batch && (prior == nil || prior.Batch.IsNull()) {
+		m.Batch = types.BoolNull()
+	}
+
+	return m
+}
+
+// jobDataToAPI generates the API request body of the job data.
+func jobDataToAPI(m []jobDataModel) []jobData {
+	data := []jobData{}
+	for _, d := range m {
+		item := jobData{
+			Type:   d.Type.ValueString(),
+			Target: d.Target.ValueString(),
+			Path:   d.Path.ValueString(),
+		}
+
+		if !d.Value.IsNull() && !d.Value.IsUnknown() {
+			item.Value = json.RawMessage(d.Value.ValueString())
+		}
+
+		data = append(data, item)
+	}
+
+	return data
+}
+
+// jobDataFromAPI maps the job data received from the API to the model,
+// keeping semantically equal values as configured.
+func jobDataFromAPI(prior []jobDataModel, data []jobData) []jobDataModel {
+	m := []jobDataModel{}
+	for i, d := range data {
+		item := jobDataModel{
+			Type:   types.StringValue(d.Type),
+			Target: types.StringValue(d.Target),
+			Path:   types.StringValue(d.Path),
+			Value:  types.StringNull(),
+		}
+
+		if len(d.Value) > 0 && string(d.Value) != "null" {
+			priorValue := types.StringNull()
+			if i < len(prior) {
+				priorValue = prior[i].Value
+			}
+
+			item.Value = jsonStateValue(priorValue, d.Value)
+		}
+
+		m = append(m, item)
+	}
+
+	return m
+}
+
+// jobOrganizationsToAPI returns the organization IDs of a job, or an empty
+// list when it belongs to no organization.
+func jobOrganizationsToAPI(ctx context.Context, organizations types.List) ([]string, diag.Diagnostics) {
+	ids := []string{}
+	if organizations.IsNull() || organizations.IsUnknown() {
+		return ids, nil
+	}
+
+	diags := organizations.ElementsAs(ctx, &ids, false)
+	return ids, diags
+}
+
+// jobOrganizationsFromAPI maps the organization IDs of a job received from the
+// API, keeping an unset list unset when the job belongs to no organization.
+func jobOrganizationsFromAPI(ctx context.Context, prior types.List, ids []string) (types.List, diag.Diagnostics) {
+	if len(ids) == 0 && (prior.IsNull() || prior.IsUnknown()) {
+		return types.ListNull(types.StringType), nil
+	}
+
+	if ids == nil {
+		ids = []string{}
+	}
+
+	return types.ListValueFrom(ctx, types.StringType, ids)
+}
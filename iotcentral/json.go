@@ -0,0 +1,107 @@
+package iotcentral
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// jsonEqual reports whether two JSON documents are semantically equal,
+// ignoring whitespace and the order of object keys.
+func jsonEqual(a, b string) bool {
+	var av, bv any
+	if err := json.Unmarshal([]byte(a), &av); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(b), &bv); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(av, bv)
+}
+
+// jsonStateValue returns the JSON document received from the API as a state
+// value, keeping the prior value when it is semantically equal so formatting
+// differences do not show up as drift.
+func jsonStateValue(prior types.String, received json.RawMessage) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() && jsonEqual(prior.ValueString(), string(received)) {
+		return prior
+	}
+
+	return types.StringValue(string(received))
+}
+
+// suppressEquivalentJSON returns a plan modifier that keeps the prior state
+// value when the configured JSON is semantically equal to it.
+func suppressEquivalentJSON() planmodifier.String {
+	return suppressEquivalentJSONModifier{}
+}
+
+// suppressEquivalentJSONModifier implements the plan modifier.
+type suppressEquivalentJSONModifier struct{}
+
+// Description returns a human-readable description of the plan modifier.
+func (m suppressEquivalentJSONModifier) Description(_ context.Context) string {
+	return "Semantically equal JSON documents do not cause a change."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m suppressEquivalentJSONModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString implements the plan modification logic.
+func (m suppressEquivalentJSONModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if jsonEqual(req.StateValue.ValueString(), req.PlanValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// jsonValidator validates that a string is a JSON object, or any JSON value
+// when anyValue is set.
+type jsonValidator struct {
+	anyValue bool
+}
+
+// Description describes the validation in plain text formatting.
+func (v jsonValidator) Description(_ context.Context) string {
+	if v.anyValue {
+		return "value must be valid JSON"
+	}
+
+	return "value must be a JSON object"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v jsonValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v jsonValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var value any = &map[string]any{}
+	if v.anyValue {
+		value = new(any)
+	}
+
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON",
+			"Attribute "+req.Path.String()+" "+v.Description(ctx)+": "+err.Error(),
+		)
+	}
+}
@@ -0,0 +1,117 @@
+package iotcentral
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestJSONEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want bool
+	}{
+		{name: "identical", a: `{"a":1}`, b: `{"a":1}`, want: true},
+		{name: "whitespace", a: `{"a": 1}`, b: "{\n  \"a\":1\n}", want: true},
+		{name: "key order", a: `{"a":1,"b":[1,2]}`, b: `{"b":[1,2],"a":1}`, want: true},
+		{name: "number format", a: `{"a":1}`, b: `{"a":1.0}`, want: true},
+		{name: "different value", a: `{"a":1}`, b: `{"a":2}`, want: false},
+		{name: "array order", a: `[1,2]`, b: `[2,1]`, want: false},
+		{name: "invalid", a: `{"a":1}`, b: `{"a":`, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jsonEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("jsonEqual(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONStateValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		prior    types.String
+		received string
+		want     types.String
+	}{
+		{name: "equal keeps prior", prior: types.StringValue(`{"a": 1}`), received: `{"a":1}`, want: types.StringValue(`{"a": 1}`)},
+		{name: "different", prior: types.StringValue(`{"a": 1}`), received: `{"a":2}`, want: types.StringValue(`{"a":2}`)},
+		{name: "null prior", prior: types.StringNull(), received: `{"a":1}`, want: types.StringValue(`{"a":1}`)},
+		{name: "unknown prior", prior: types.StringUnknown(), received: `{"a":1}`, want: types.StringValue(`{"a":1}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jsonStateValue(tt.prior, json.RawMessage(tt.received)); !got.Equal(tt.want) {
+				t.Errorf("jsonStateValue() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSuppressEquivalentJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		state types.String
+		plan  types.String
+		want  types.String
+	}{
+		{name: "equivalent", state: types.StringValue(`{"a":1,"b":2}`), plan: types.StringValue(`{"b": 2, "a": 1}`), want: types.StringValue(`{"a":1,"b":2}`)},
+		{name: "changed", state: types.StringValue(`{"a":1}`), plan: types.StringValue(`{"a":2}`), want: types.StringValue(`{"a":2}`)},
+		{name: "create", state: types.StringNull(), plan: types.StringValue(`{"a":1}`), want: types.StringValue(`{"a":1}`)},
+		{name: "unknown", state: types.StringValue(`{"a":1}`), plan: types.StringUnknown(), want: types.StringUnknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := planmodifier.StringRequest{StateValue: tt.state, PlanValue: tt.plan}
+			resp := &planmodifier.StringResponse{PlanValue: tt.plan}
+
+			suppressEquivalentJSON().PlanModifyString(context.Background(), req, resp)
+
+			if !resp.PlanValue.Equal(tt.want) {
+				t.Errorf("PlanModifyString() = %s, want %s", resp.PlanValue, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONValidator(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    types.String
+		anyValue bool
+		wantErr  bool
+	}{
+		{name: "object", value: types.StringValue(`{"a":1}`)},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+		{name: "array", value: types.StringValue(`[1]`), wantErr: true},
+		{name: "invalid", value: types.StringValue(`{"a":`), wantErr: true},
+		{name: "any array", value: types.StringValue(`[1]`), anyValue: true},
+		{name: "any number", value: types.StringValue(`42`), anyValue: true},
+		{name: "any invalid", value: types.StringValue(`{"a":`), anyValue: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("test"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+
+			jsonValidator{anyValue: tt.anyValue}.ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("ValidateString(%s) errors = %v, wantErr %v", tt.value, resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
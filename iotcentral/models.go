@@ -7,3 +7,13 @@ type roleAssignmentResourceModel struct {
 	Organization types.String `tfsdk:"organization"`
 	Role         types.String `tfsdk:"role"`
 }
+
+// optionalStringValue returns a string value, or a null value when the string
+// received from the API is empty.
+func optionalStringValue(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(s)
+}
@@ -3,9 +3,10 @@ package iotcentral
 import (
 	"context"
 	"os"
+	"strconv"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -13,7 +14,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	iotcentral "github.com/kenspur/azure-iot-central-client-go"
 )
 
 // Ensure the implementation satisfies the expected interfaces
@@ -31,7 +31,27 @@ type iotcentralProvider struct{}
 
 // iotcentralProviderModel maps provider schema data to a Go type.
 type iotcentralProviderModel struct {
-	Host types.String `tfsdk:"host"`
+	Host                      types.String `tfsdk:"host"`
+	ApplicationSubdomain      types.String `tfsdk:"application_subdomain"`
+	ApplicationID             types.String `tfsdk:"application_id"`
+	SubscriptionID            types.String `tfsdk:"subscription_id"`
+	APIToken                  types.String `tfsdk:"api_token"`
+	Environment               types.String `tfsdk:"environment"`
+	TokenScope                types.String `tfsdk:"token_scope"`
+	AuthorityHost             types.String `tfsdk:"authority_host"`
+	TenantID                  types.String `tfsdk:"tenant_id"`
+	ClientID                  types.String `tfsdk:"client_id"`
+	ClientSecret              types.String `tfsdk:"client_secret"`
+	ClientCertificatePath     types.String `tfsdk:"client_certificate_path"`
+	ClientCertificatePassword types.String `tfsdk:"client_certificate_password"`
+	UseOIDC                   types.Bool   `tfsdk:"use_oidc"`
+	OIDCTokenFilePath         types.String `tfsdk:"oidc_token_file_path"`
+	UseMSI                    types.Bool   `tfsdk:"use_msi"`
+	UseCLI                    types.Bool   `tfsdk:"use_cli"`
+	MaxRetries                types.Int64  `tfsdk:"max_retries"`
+	RetryMinDelay             types.String `tfsdk:"retry_min_delay"`
+	RetryMaxDelay             types.String `tfsdk:"retry_max_delay"`
+	MaxConcurrentRequests     types.Int64  `tfsdk:"max_concurrent_requests"`
 }
 
 // Metadata returns the provider type name.
@@ -44,7 +64,90 @@ func (p *iotcentralProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
-				Description: "IoT Central Application URL. May also be provided via IOTCENTRAL_HOST environment variable.",
+				Description: "IoT Central Application URL. Conflicts with application_subdomain and application_id. May also be provided via IOTCENTRAL_HOST environment variable.",
+				Optional:    true,
+			},
+			"application_subdomain": schema.StringAttribute{
+				Description: "Subdomain of the IoT Central application, used instead of host. May also be provided via IOTCENTRAL_APPLICATION_SUBDOMAIN environment variable.",
+				Optional:    true,
+			},
+			"application_id": schema.StringAttribute{
+				Description: "Application ID of the IoT Central application, used instead of host. The application is looked up in the subscription_id subscription with Azure AD authentication. May also be provided via IOTCENTRAL_APPLICATION_ID environment variable.",
+				Optional:    true,
+			},
+			"subscription_id": schema.StringAttribute{
+				Description: "The Azure subscription containing the application, required when application_id is set. May also be provided via ARM_SUBSCRIPTION_ID environment variable.",
+				Optional:    true,
+			},
+			"api_token": schema.StringAttribute{
+				Description: "IoT Central API token (SharedAccessSignature) used instead of Azure AD authentication. Takes precedence over Azure AD settings provided via ARM_* environment variables. May also be provided via IOTCENTRAL_API_TOKEN environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"environment": schema.StringAttribute{
+				Description: "The Azure cloud hosting the application, one of public, china, usgovernment or custom. Defaults to public. May also be provided via ARM_ENVIRONMENT environment variable.",
+				Optional:    true,
+			},
+			"token_scope": schema.StringAttribute{
+				Description: "Overrides the scope of the access tokens requested for the application. Required for the custom environment. May also be provided via IOTCENTRAL_TOKEN_SCOPE environment variable.",
+				Optional:    true,
+			},
+			"authority_host": schema.StringAttribute{
+				Description: "Overrides the Azure AD authority host used to request access tokens. Required for the custom environment. May also be provided via ARM_AUTHORITY_HOST or AZURE_AUTHORITY_HOST environment variables.",
+				Optional:    true,
+			},
+			"tenant_id": schema.StringAttribute{
+				Description: "The Azure AD tenant ID to authenticate against. May also be provided via ARM_TENANT_ID environment variable.",
+				Optional:    true,
+			},
+			"client_id": schema.StringAttribute{
+				Description: "The client ID of the service principal or user assigned managed identity. May also be provided via ARM_CLIENT_ID environment variable.",
+				Optional:    true,
+			},
+			"client_secret": schema.StringAttribute{
+				Description: "The client secret of the service principal. May also be provided via ARM_CLIENT_SECRET environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"client_certificate_path": schema.StringAttribute{
+				Description: "The path to a PFX or PEM client certificate of the service principal. May also be provided via ARM_CLIENT_CERTIFICATE_PATH environment variable.",
+				Optional:    true,
+			},
+			"client_certificate_password": schema.StringAttribute{
+				Description: "The password of the client certificate. May also be provided via ARM_CLIENT_CERTIFICATE_PASSWORD environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"use_oidc": schema.BoolAttribute{
+				Description: "Authenticate the service principal with an OIDC token. May also be provided via ARM_USE_OIDC environment variable.",
+				Optional:    true,
+			},
+			"oidc_token_file_path": schema.StringAttribute{
+				Description: "The path to a file containing the OIDC token. May also be provided via ARM_OIDC_TOKEN_FILE_PATH or AZURE_FEDERATED_TOKEN_FILE environment variables.",
+				Optional:    true,
+			},
+			"use_msi": schema.BoolAttribute{
+				Description: "Authenticate with a managed identity. May also be provided via ARM_USE_MSI environment variable.",
+				Optional:    true,
+			},
+			"use_cli": schema.BoolAttribute{
+				Description: "Authenticate with the Azure CLI. May also be provided via ARM_USE_CLI environment variable.",
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times a throttled (429) API request, or a failed (5xx) request that is safe to repeat, is retried. Create and action requests are not repeated after a failure. Defaults to 3. May also be provided via IOTCENTRAL_MAX_RETRIES environment variable.",
+				Optional:    true,
+			},
+			"retry_min_delay": schema.StringAttribute{
+				Description: "Initial delay between retries as a duration such as `1s`, doubled on every retry unless the API sends a Retry-After header. Defaults to `1s`. May also be provided via IOTCENTRAL_RETRY_MIN_DELAY environment variable.",
+				Optional:    true,
+			},
+			"retry_max_delay": schema.StringAttribute{
+				Description: "Maximum delay between retries as a duration such as `30s`, also applied to delays requested by the API. Defaults to `30s`. May also be provided via IOTCENTRAL_RETRY_MAX_DELAY environment variable.",
+				Optional:    true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of API requests in flight at the same time across all resources and data sources. Defaults to 10. May also be provided via IOTCENTRAL_MAX_CONCURRENT_REQUESTS environment variable.",
 				Optional:    true,
 			},
 		},
@@ -75,6 +178,41 @@ func (p *iotcentralProvider) Configure(ctx context.Context, req provider.Configu
 		)
 	}
 
+	for _, attribute := range []struct {
+		name  string
+		value attr.Value
+	}{
+		{"application_subdomain", config.ApplicationSubdomain},
+		{"application_id", config.ApplicationID},
+		{"subscription_id", config.SubscriptionID},
+		{"api_token", config.APIToken},
+		{"environment", config.Environment},
+		{"token_scope", config.TokenScope},
+		{"authority_host", config.AuthorityHost},
+		{"tenant_id", config.TenantID},
+		{"client_id", config.ClientID},
+		{"client_secret", config.ClientSecret},
+		{"client_certificate_path", config.ClientCertificatePath},
+		{"client_certificate_password", config.ClientCertificatePassword},
+		{"use_oidc", config.UseOIDC},
+		{"oidc_token_file_path", config.OIDCTokenFilePath},
+		{"use_msi", config.UseMSI},
+		{"use_cli", config.UseCLI},
+		{"max_retries", config.MaxRetries},
+		{"retry_min_delay", config.RetryMinDelay},
+		{"retry_max_delay", config.RetryMaxDelay},
+		{"max_concurrent_requests", config.MaxConcurrentRequests},
+	} {
+		if attribute.value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute.name),
+				"Unknown IotCentral Provider Setting",
+				"The provider cannot create the IotCentral API client as there is an unknown configuration value for "+attribute.name+". "+
+					"Either target apply the source of the value first, set the value statically in the configuration, or use the matching environment variable.",
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -83,85 +221,306 @@ func (p *iotcentralProvider) Configure(ctx context.Context, req provider.Configu
 	// with Terraform configuration value if set.
 
 	host := os.Getenv("IOTCENTRAL_HOST")
+	applicationSubdomain := os.Getenv("IOTCENTRAL_APPLICATION_SUBDOMAIN")
+	applicationID := os.Getenv("IOTCENTRAL_APPLICATION_ID")
 
-	if !config.Host.IsNull() {
+	// An application selected in the configuration replaces the
+	// environment variables instead of conflicting with them.
+	if !config.Host.IsNull() || !config.ApplicationSubdomain.IsNull() || !config.ApplicationID.IsNull() {
 		host = config.Host.ValueString()
+		applicationSubdomain = config.ApplicationSubdomain.ValueString()
+		applicationID = config.ApplicationID.ValueString()
+	}
+
+	subscriptionID := stringValueOrEnv(config.SubscriptionID, "ARM_SUBSCRIPTION_ID")
+
+	apiToken := stringValueOrEnv(config.APIToken, "IOTCENTRAL_API_TOKEN")
+
+	environmentName := stringValueOrEnv(config.Environment, "ARM_ENVIRONMENT")
+	if environmentName == "" {
+		environmentName = "public"
+	}
+
+	credentialConfig := azureCredentialConfig{
+		TenantID:                  stringValueOrEnv(config.TenantID, "ARM_TENANT_ID"),
+		ClientID:                  stringValueOrEnv(config.ClientID, "ARM_CLIENT_ID"),
+		ClientSecret:              stringValueOrEnv(config.ClientSecret, "ARM_CLIENT_SECRET"),
+		ClientCertificatePath:     stringValueOrEnv(config.ClientCertificatePath, "ARM_CLIENT_CERTIFICATE_PATH"),
+		ClientCertificatePassword: stringValueOrEnv(config.ClientCertificatePassword, "ARM_CLIENT_CERTIFICATE_PASSWORD"),
+		UseOIDC:                   boolValueOrEnv(config.UseOIDC, "ARM_USE_OIDC"),
+		OIDCTokenFilePath:         stringValueOrEnv(config.OIDCTokenFilePath, "ARM_OIDC_TOKEN_FILE_PATH", "AZURE_FEDERATED_TOKEN_FILE"),
+		UseMSI:                    boolValueOrEnv(config.UseMSI, "ARM_USE_MSI"),
+		UseCLI:                    boolValueOrEnv(config.UseCLI, "ARM_USE_CLI"),
+	}
+
+	retry := retryPolicy{
+		MaxRetries: defaultMaxRetries,
+		MinDelay:   defaultRetryMinDelay,
+		MaxDelay:   defaultRetryMaxDelay,
+	}
+
+	if maxRetries, err := int64ValueOrEnv(config.MaxRetries, "IOTCENTRAL_MAX_RETRIES", defaultMaxRetries); err != nil || maxRetries < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Invalid IotCentral Retry Setting",
+			"The provider cannot create the IotCentral API client as max_retries must be a number greater than or equal to 0.",
+		)
+	} else {
+		retry.MaxRetries = int(maxRetries)
+	}
+
+	for _, setting := range []struct {
+		name  string
+		value types.String
+		env   string
+		delay *time.Duration
+	}{
+		{"retry_min_delay", config.RetryMinDelay, "IOTCENTRAL_RETRY_MIN_DELAY", &retry.MinDelay},
+		{"retry_max_delay", config.RetryMaxDelay, "IOTCENTRAL_RETRY_MAX_DELAY", &retry.MaxDelay},
+	} {
+		value := stringValueOrEnv(setting.value, setting.env)
+		if value == "" {
+			continue
+		}
+
+		delay, err := time.ParseDuration(value)
+		if err != nil || delay < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(setting.name),
+				"Invalid IotCentral Retry Setting",
+				"The provider cannot create the IotCentral API client as "+setting.name+" must be a positive duration such as 1s or 500ms, got: "+value,
+			)
+			continue
+		}
+
+		*setting.delay = delay
+	}
+
+	if retry.MinDelay > retry.MaxDelay {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_min_delay"),
+			"Invalid IotCentral Retry Setting",
+			"The provider cannot create the IotCentral API client as retry_min_delay must not be greater than retry_max_delay.",
+		)
+	}
+
+	maxConcurrentRequests, err := int64ValueOrEnv(config.MaxConcurrentRequests, "IOTCENTRAL_MAX_CONCURRENT_REQUESTS", defaultMaxConcurrentRequests)
+	if err != nil || maxConcurrentRequests < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Invalid IotCentral Concurrency Setting",
+			"The provider cannot create the IotCentral API client as max_concurrent_requests must be a number greater than or equal to 1.",
+		)
 	}
 
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
-	if host == "" {
+	applicationSettings := 0
+	for _, value := range []string{host, applicationSubdomain, applicationID} {
+		if value != "" {
+			applicationSettings++
+		}
+	}
+
+	if applicationSettings == 0 {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("host"),
 			"Missing IotCentral API Host",
 			"The provider cannot create the IotCentral API client as there is a missing or empty value for the IotCentral API host. "+
-				"Set the host value in the configuration or use the IOTCENTRAL_HOST environment variable. "+
+				"Set the host, application_subdomain or application_id value in the configuration or use the IOTCENTRAL_HOST, "+
+				"IOTCENTRAL_APPLICATION_SUBDOMAIN or IOTCENTRAL_APPLICATION_ID environment variable. "+
 				"If either is already set, ensure the value is not empty.",
 		)
+	} else if applicationSettings > 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("host"),
+			"Conflicting IotCentral API Host Settings",
+			"The provider cannot create the IotCentral API client as more than one of host, application_subdomain and application_id is set. "+
+				"Set exactly one of them in the configuration or through their environment variables.",
+		)
+	}
+
+	if applicationID != "" && apiToken != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("application_id"),
+			"Unsupported IotCentral Application ID",
+			"The provider cannot look up the application by its application ID when using an IotCentral API token. "+
+				"Set host or application_subdomain instead.",
+		)
+	}
+
+	environment, err := lookupEnvironment(
+		environmentName,
+		stringValueOrEnv(config.TokenScope, "IOTCENTRAL_TOKEN_SCOPE"),
+		stringValueOrEnv(config.AuthorityHost, "ARM_AUTHORITY_HOST", "AZURE_AUTHORITY_HOST"),
+	)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("environment"),
+			"Invalid IotCentral Environment",
+			"The provider cannot create the IotCentral API client as the environment configuration is invalid: "+err.Error(),
+		)
 	}
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	ctx = tflog.SetField(ctx, "iotcentral_host", host)
+	hostPath := path.Root("host")
+	if applicationSubdomain != "" {
+		hostPath = path.Root("application_subdomain")
+		host, err = environment.applicationHost(applicationSubdomain)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("application_subdomain"),
+				"Invalid IotCentral Application Subdomain",
+				"The provider cannot create the IotCentral API client: "+err.Error(),
+			)
+		}
+	}
 
-	// Create default Azure credential
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to create default Azure credential",
-			"An unexpected error occurred when creating default Azure credential. "+
-				"If the error is not clear, please contact the provider developers.\n\n"+
-				"Azure identity Client Error: "+err.Error(),
+	if host != "" {
+		host, err = normalizeHost(host)
+		if err == nil {
+			err = environment.validateHost(host)
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				hostPath,
+				"Invalid IotCentral API Host",
+				"The provider cannot create the IotCentral API client as the host is not a valid application URL for the "+environmentName+" environment: "+err.Error()+". "+
+					"Set the host to the application URL, such as https://<subdomain>."+environment.Domain+", "+
+					"and the environment attribute to the cloud hosting the application.",
+			)
+		}
+	}
+
+	if apiToken != "" && config.configuresAzureAuthentication() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_token"),
+			"Conflicting IotCentral Authentication Settings",
+			"The provider cannot create the IotCentral API client as both an IotCentral API token and Azure AD authentication settings are configured. "+
+				"Either remove the api_token value and the IOTCENTRAL_API_TOKEN environment variable, "+
+				"or remove the client_secret, client_certificate_path, use_oidc, use_msi and use_cli settings from the configuration.",
 		)
+	}
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Prepare the token request options
-	policy := policy.TokenRequestOptions{
-		Scopes: []string{"https://apps.azureiotcentral.com/.default"},
+	ctx = tflog.SetField(ctx, "iotcentral_environment", environmentName)
+
+	credentialConfig.AuthorityHost = environment.AuthorityHost
+
+	// All requests of the provider count against the same request limit
+	limiter := newRequestLimiter(int(maxConcurrentRequests))
+
+	var auth authorizer
+	if apiToken != "" {
+		tflog.Debug(ctx, "Using IotCentral API token authentication")
+
+		auth = newAPITokenAuthorizer(apiToken)
+	} else {
+		bearerAuth := p.azureAuthorizer(ctx, credentialConfig, environment.TokenScope, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		auth = bearerAuth
+
+		if applicationID != "" {
+			tflog.Debug(ctx, "Looking up IotCentral application subdomain", map[string]any{"iotcentral_application_id": applicationID})
+
+			// Resource Manager requests share the retry settings and the request limit of the API client
+			armClient := newHTTPClient(clientConfig{
+				Auth:    newBearerTokenAuthorizer(bearerAuth.credential, environment.ResourceManagerEndpoint+"/.default"),
+				Retry:   retry,
+				Limiter: limiter,
+			})
+
+			subdomain, err := lookupApplicationSubdomain(ctx, armClient, environment, subscriptionID, applicationID)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("application_id"),
+					"Unable to Find IotCentral Application",
+					"The provider cannot resolve the application host from the application ID. "+
+						"Ensure subscription_id is set and the credential can read the application in Azure Resource Manager.\n\n"+
+						"Error: "+err.Error(),
+				)
+				return
+			}
+
+			// Resolved hosts are built from the environment domain and need no validation
+			host, _ = environment.applicationHost(subdomain)
+		}
 	}
 
-	// Get the access token
-	token, err := cred.GetToken(ctx, policy)
+	ctx = tflog.SetField(ctx, "iotcentral_host", host)
+
+	tflog.Debug(ctx, "Creating IotCentral client")
+
+	// Create a new IotCentral client using the configuration values
+	client := newClient(clientConfig{
+		Host:    host,
+		Auth:    auth,
+		Retry:   retry,
+		Limiter: limiter,
+	})
+
+	// Make the IotCentral client available during DataSource and Resource
+	// type Configure methods.
+	resp.DataSourceData = client
+	resp.ResourceData = client
+
+	tflog.Info(ctx, "Configured IotCentral client", map[string]any{"success": true})
+}
+
+// azureAuthorizer creates an authorizer that requests Azure AD access tokens
+// with the configured authentication methods.
+func (p *iotcentralProvider) azureAuthorizer(ctx context.Context, config azureCredentialConfig, tokenScope string, resp *provider.ConfigureResponse) *bearerTokenAuthorizer {
+	// Create the Azure credential from the configured authentication methods
+	cred, err := newAzureCredential(config)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unable to get Azure access token",
-			"An unexpected error occurred when getting the Azure access token. "+
+			"Unable to create Azure credential",
+			"An unexpected error occurred when creating the Azure credential. "+
 				"If the error is not clear, please contact the provider developers.\n\n"+
 				"Azure identity Client Error: "+err.Error(),
 		)
-		return
+		return nil
 	}
 
-	tflog.Debug(ctx, "Creating IotCentral client")
+	// Tokens are requested per API call and refreshed before they expire, so
+	// long running applies keep working after the first token lapses.
+	auth := newBearerTokenAuthorizer(cred, tokenScope)
 
-	// Create a new IotCentral client using the configuration values
-	client, err := iotcentral.NewClient(&host, &token.Token)
+	// Get the initial access token to surface credential problems early
+	_, err = auth.authorization(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unable to Create IotCentral API Client",
-			"An unexpected error occurred when creating the IotCentral API client. "+
+			"Unable to get Azure access token",
+			"An unexpected error occurred when getting the Azure access token. "+
 				"If the error is not clear, please contact the provider developers.\n\n"+
-				"IotCentral Client Error: "+err.Error(),
+				"Azure identity Client Error: "+err.Error(),
 		)
-		return
+		return nil
 	}
 
-	// Make the IotCentral client available during DataSource and Resource
-	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
-
-	tflog.Info(ctx, "Configured IotCentral client", map[string]any{"success": true})
+	return auth
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *iotcentralProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewRoleDataSource,
+		NewDeviceCredentialsDataSource,
+		NewOrganizationsDataSource,
+		NewOrganizationDataSource,
+		NewRolesDataSource,
+		NewUsersDataSource,
 	}
 }
 
@@ -172,5 +531,73 @@ func (p *iotcentralProvider) Resources(_ context.Context) []func() resource.Reso
 		NewUserResource,
 		NewADGroupUserResource,
 		NewServicePrincipalUserResource,
+		NewDeviceTemplateResource,
+		NewDeviceResource,
+		NewDeviceGroupResource,
+		NewAPITokenResource,
+		NewDestinationResource,
+		NewDataExportResource,
+		NewEnrollmentGroupResource,
+		NewEnrollmentGroupCertificateResource,
+		NewJobResource,
+		NewScheduledJobResource,
+		NewFileUploadResource,
+		NewDeploymentManifestResource,
+		NewDeviceDeploymentManifestAssignmentResource,
+	}
+}
+
+// configuresAzureAuthentication reports whether the provider configuration
+// selects an Azure AD authentication method. Methods only enabled through
+// ARM_* environment variables, which are often exported for other providers,
+// yield to an API token instead of conflicting with it.
+func (c iotcentralProviderModel) configuresAzureAuthentication() bool {
+	return !c.ClientSecret.IsNull() || !c.ClientCertificatePath.IsNull() ||
+		c.UseOIDC.ValueBool() || c.UseMSI.ValueBool() || c.UseCLI.ValueBool()
+}
+
+// stringValueOrEnv returns the configured value, or the first non-empty
+// environment variable when the attribute is not set.
+func stringValueOrEnv(value types.String, keys ...string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+
+	for _, key := range keys {
+		if v := os.Getenv(key); v != "" {
+			return v
+		}
 	}
+
+	return ""
+}
+
+// boolValueOrEnv returns the configured value, or the parsed environment
+// variable when the attribute is not set.
+func boolValueOrEnv(value types.Bool, key string) bool {
+	if !value.IsNull() {
+		return value.ValueBool()
+	}
+
+	v, err := strconv.ParseBool(os.Getenv(key))
+	if err != nil {
+		return false
+	}
+
+	return v
+}
+
+// int64ValueOrEnv returns the configured value, the parsed environment
+// variable, or the default when neither is set.
+func int64ValueOrEnv(value types.Int64, key string, defaultValue int64) (int64, error) {
+	if !value.IsNull() {
+		return value.ValueInt64(), nil
+	}
+
+	v := os.Getenv(key)
+	if v == "" {
+		return defaultValue, nil
+	}
+
+	return strconv.ParseInt(v, 10, 64)
 }
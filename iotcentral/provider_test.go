@@ -1,7 +1,10 @@
 package iotcentral
 
 import (
+	"testing"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -24,3 +27,28 @@ var (
 		"iotcentral": providerserver.NewProtocol6WithError(New()),
 	}
 )
+
+func TestConfiguresAzureAuthentication(t *testing.T) {
+	t.Setenv("ARM_CLIENT_SECRET", "secret")
+	t.Setenv("ARM_USE_MSI", "true")
+
+	tests := []struct {
+		name   string
+		config iotcentralProviderModel
+		want   bool
+	}{
+		{name: "environment only", config: iotcentralProviderModel{}, want: false},
+		{name: "client secret", config: iotcentralProviderModel{ClientSecret: types.StringValue("secret")}, want: true},
+		{name: "client certificate", config: iotcentralProviderModel{ClientCertificatePath: types.StringValue("cert.pem")}, want: true},
+		{name: "managed identity", config: iotcentralProviderModel{UseMSI: types.BoolValue(true)}, want: true},
+		{name: "disabled cli", config: iotcentralProviderModel{UseCLI: types.BoolValue(false)}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.configuresAzureAuthentication(); got != tt.want {
+				t.Errorf("configuresAzureAuthentication() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
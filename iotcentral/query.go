@@ -0,0 +1,98 @@
+package iotcentral
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// queryPattern matches the structure of an IotCentral query, such as
+// `SELECT * FROM devices WHERE $template = "dtmi:example:thermostat;1"`.
+var queryPattern = regexp.MustCompile(`(?is)^SELECT\s+(.+?)\s+FROM\s+(\S+)(?:\s+WHERE\s+(.*))?$`)
+
+// validateQuery checks the syntax of an IotCentral query as far as possible
+// without the application. When from is set, the query must select from it.
+func validateQuery(query, from string) error {
+	match := queryPattern.FindStringSubmatch(strings.TrimSpace(query))
+	if match == nil {
+		return fmt.Errorf("expected a query of the form `SELECT ... FROM ... [WHERE ...]`")
+	}
+
+	if from != "" && !strings.EqualFold(match[2], from) {
+		return fmt.Errorf("expected the query to select FROM %s, got %s", from, match[2])
+	}
+
+	return checkQueryCondition(match[3])
+}
+
+// checkQueryCondition checks that the quotes and parentheses of a query
+// condition are balanced.
+func checkQueryCondition(condition string) error {
+	var quote rune
+	depth := 0
+
+	for _, c := range condition {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth < 0 {
+				return fmt.Errorf("unexpected closing parenthesis in condition")
+			}
+		}
+	}
+
+	if quote != 0 {
+		return fmt.Errorf("unterminated string %c in condition", quote)
+	}
+
+	if depth > 0 {
+		return fmt.Errorf("missing closing parenthesis in condition")
+	}
+
+	return nil
+}
+
+// queryValidator validates the syntax of an IotCentral query.
+type queryValidator struct {
+	from string
+}
+
+// Description describes the validation in plain text formatting.
+func (v queryValidator) Description(_ context.Context) string {
+	if v.from != "" {
+		return "value must be a query of the form `SELECT ... FROM " + v.from + " [WHERE ...]`"
+	}
+
+	return "value must be a query of the form `SELECT ... FROM ... [WHERE ...]`"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v queryValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v queryValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateQuery(req.ConfigValue.ValueString(), v.from); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Query",
+			"Attribute "+req.Path.String()+" is not a valid IotCentral query: "+err.Error(),
+		)
+	}
+}
@@ -0,0 +1,32 @@
+package iotcentral
+
+import "testing"
+
+func TestValidateQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		from    string
+		wantErr bool
+	}{
+		{name: "select all", query: "SELECT * FROM devices"},
+		{name: "condition", query: `SELECT * FROM devices WHERE $template = "dtmi:example:thermostat;1"`, from: "devices"},
+		{name: "lower case", query: "select * from devices where $provisioned = true", from: "devices"},
+		{name: "multiline", query: "SELECT *\nFROM devices\nWHERE ($simulated = false)", from: "devices"},
+		{name: "parenthesis in string", query: `SELECT * FROM devices WHERE $displayName = "a (b"`},
+		{name: "not a query", query: "devices", wantErr: true},
+		{name: "other source", query: "SELECT * FROM dtmi:example", from: "devices", wantErr: true},
+		{name: "unterminated string", query: `SELECT * FROM devices WHERE $displayName = "a`, wantErr: true},
+		{name: "missing parenthesis", query: "SELECT * FROM devices WHERE ($simulated = false", wantErr: true},
+		{name: "extra parenthesis", query: "SELECT * FROM devices WHERE $simulated = false)", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateQuery(tt.query, tt.from)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateQuery(%q, %q) error = %v, wantErr %v", tt.query, tt.from, err, tt.wantErr)
+			}
+		})
+	}
+}
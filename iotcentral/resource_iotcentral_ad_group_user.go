@@ -3,12 +3,14 @@ package iotcentral
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	iotcentral "github.com/kenspur/azure-iot-central-client-go"
 )
 
@@ -35,6 +37,7 @@ type adGroupUserResourceModel struct {
 	ObjectID types.String                  `tfsdk:"object_id"`
 	TenantID types.String                  `tfsdk:"tenant_id"`
 	Roles    []roleAssignmentResourceModel `tfsdk:"roles"`
+	Timeouts timeouts.Value                `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -43,7 +46,7 @@ func (r *adGroupUserResource) Metadata(_ context.Context, req resource.MetadataR
 }
 
 // Schema defines the schema for the resource.
-func (r *adGroupUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *adGroupUserResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -81,6 +84,9 @@ func (r *adGroupUserResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -121,9 +127,22 @@ func (r *adGroupUserResource) Create(ctx context.Context, req resource.CreateReq
 		adGroupUserRequest.Roles = append(adGroupUserRequest.Roles, roleToAdd)
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Create new ad group user
-	adGroupUser, err := r.client.CreateADGroupUser(adGroupUserRequest)
+	adGroupUser, err := clientWithContext(ctx, r.client).CreateADGroupUser(adGroupUserRequest)
 	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_ad_group_user "+plan.ObjectID.ValueString(), "create", createTimeout) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error creating ad group user",
 			"Could not create ad group user, unexpected error: "+err.Error(),
@@ -167,9 +186,29 @@ func (r *adGroupUserResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed ad group user value from IotCentral
-	adGroupUser, err := r.client.GetADGroupUser(state.ID.ValueString())
+	adGroupUser, err := clientWithContext(ctx, r.client).GetADGroupUser(state.ID.ValueString())
 	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_ad_group_user "+state.ID.ValueString(), "read", readTimeout) {
+			return
+		}
+
+		// Remove the resource from state when it was deleted outside Terraform
+		if isNotFound(err) {
+			tflog.Warn(ctx, "IotCentral ad group user not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Reading IotCentral User",
 			"Could not read IotCentral ad group user ID "+state.ID.ValueString()+": "+err.Error(),
@@ -238,9 +277,22 @@ func (r *adGroupUserResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Update existing ad group user
-	adGroupUser, err := r.client.UpdateADGroupUser(state.ID.ValueString(), adGroupUserRequest)
+	adGroupUser, err := clientWithContext(ctx, r.client).UpdateADGroupUser(state.ID.ValueString(), adGroupUserRequest)
 	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_ad_group_user "+state.ID.ValueString(), "update", updateTimeout) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Updating IotCentraL User",
 			"Could not update ad group user, unexpected error: "+err.Error(),
@@ -283,9 +335,27 @@ func (r *adGroupUserResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing order
-	err := r.client.DeleteUser(state.ID.ValueString())
+	err := clientWithContext(ctx, r.client).DeleteUser(state.ID.ValueString())
 	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_ad_group_user "+state.ID.ValueString(), "delete", deleteTimeout) {
+			return
+		}
+
+		// Nothing to delete when the resource is already gone
+		if isNotFound(err) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Deleting IotCentral User",
 			"Could not delete ad group user, unexpected error: "+err.Error(),
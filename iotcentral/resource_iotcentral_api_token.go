@@ -0,0 +1,336 @@
+package iotcentral
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &apiTokenResource{}
+	_ resource.ResourceWithConfigure   = &apiTokenResource{}
+	_ resource.ResourceWithImportState = &apiTokenResource{}
+)
+
+// NewAPITokenResource is a helper function to simplify the provider implementation.
+func NewAPITokenResource() resource.Resource {
+	return &apiTokenResource{}
+}
+
+// apiTokenResource is the resource implementation.
+type apiTokenResource struct {
+	client *iotcentral.Client
+}
+
+// apiTokenResourceModel maps API token schema data.
+type apiTokenResourceModel struct {
+	ID                types.String                  `tfsdk:"id"`
+	Roles             []roleAssignmentResourceModel `tfsdk:"roles"`
+	Expiry            types.String                  `tfsdk:"expiry"`
+	Token             types.String                  `tfsdk:"token"`
+	RotateWhenChanged types.Map                     `tfsdk:"rotate_when_changed"`
+	Timeouts          timeouts.Value                `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *apiTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+// Schema defines the schema for the resource.
+func (r *apiTokenResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an API token. API tokens cannot be changed, so any change creates a new token.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique ID of the API token.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"roles": schema.SetNestedAttribute{
+				Description: "List of role assignments that specify the permissions to access the application.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Description: "ID of the role for this role assignment.",
+							Required:    true,
+						},
+						"organization": schema.StringAttribute{
+							Description: "ID of the organization for this role assignment.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"expiry": schema.StringAttribute{
+				Description: "RFC 3339 timestamp at which the API token expires. Defaults to one year after creation.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					timestampValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = req.PlanValue.IsUnknown() || !timestampEqual(req.StateValue.ValueString(), req.PlanValue.ValueString())
+						},
+						"A different expiry creates a new API token.",
+						"A different expiry creates a new API token.",
+					),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: "Value of the API token, to be used in the `Authorization` header of requests. " +
+					"Only available when the API token is created by Terraform, not after import.",
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotate_when_changed": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, creates a new API token. " +
+					"Use for example a `time_rotating` resource to rotate the token periodically.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *apiTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*iotcentral.Client)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *apiTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan apiTokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var tokenID = plan.ID.ValueString()
+	var tokenRequest = apiToken{}
+
+	if !plan.Expiry.IsNull() && !plan.Expiry.IsUnknown() {
+		tokenRequest.Expiry = plan.Expiry.ValueString()
+	}
+
+	for _, role := range plan.Roles {
+		var roleToAdd = iotcentral.RoleAssignment{
+			Role: role.Role.ValueString(),
+		}
+
+		if !role.Organization.IsNull() {
+			roleToAdd.Organization = role.Organization.ValueString()
+		}
+
+		tokenRequest.Roles = append(tokenRequest.Roles, roleToAdd)
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create new API token
+	token, err := createAPIToken(ctx, r.client, tokenID, tokenRequest)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_api_token "+tokenID, "create", createTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error creating API token",
+			"Could not create API token, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.fromAPI(token)
+	plan.Token = types.StringValue(token.Token)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *apiTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state apiTokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed API token value from IotCentral
+	token, err := getAPIToken(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_api_token "+state.ID.ValueString(), "read", readTimeout) {
+			return
+		}
+
+		// Remove the resource from state when it was deleted outside Terraform
+		if isNotFound(err) {
+			tflog.Warn(ctx, "IotCentral API token not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading IotCentral API Token",
+			"Could not read IotCentral API token ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state, the token itself is never returned
+	state.fromAPI(token)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Every attribute of an API token requires a new token, so only the timeouts
+// can change in place.
+func (r *apiTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan apiTokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *apiTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state apiTokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing API token
+	err := deleteAPIToken(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_api_token "+state.ID.ValueString(), "delete", deleteTimeout) {
+			return
+		}
+
+		// Nothing to delete when the resource is already gone
+		if isNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting IotCentral API Token",
+			"Could not delete API token, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *apiTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// fromAPI maps an API token received from the API to the model.
+func (m *apiTokenResourceModel) fromAPI(token *apiToken) {
+	m.ID = types.StringValue(token.ID)
+	m.Expiry = timestampStateValue(m.Expiry, token.Expiry)
+
+	m.Roles = []roleAssignmentResourceModel{}
+	for _, role := range token.Roles {
+		var roleToAdd = roleAssignmentResourceModel{
+			Role: types.StringValue(role.Role),
+		}
+
+		if role.Organization != "" {
+			roleToAdd.Organization = types.StringValue(role.Organization)
+		}
+
+		m.Roles = append(m.Roles, roleToAdd)
+	}
+
+	// The token is only known when it was created by Terraform
+	if m.Token.IsUnknown() {
+		m.Token = types.StringNull()
+	}
+}
@@ -0,0 +1,83 @@
+package iotcentral
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIotCentralAPITokenResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				data "iotcentral_role" "test" {
+					display_name = "Operator"
+				}
+
+				resource "iotcentral_api_token" "test" {
+					id = "testapitoken"
+					expiry = "2030-01-01T00:00:00Z"
+					roles = [
+						{
+							role = data.iotcentral_role.test.id
+						}
+					]
+					rotate_when_changed = {
+						rotation = "1"
+					}
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify id is set
+					resource.TestCheckResourceAttr("iotcentral_api_token.test", "id", "testapitoken"),
+					// Verify expiry is set
+					resource.TestCheckResourceAttr("iotcentral_api_token.test", "expiry", "2030-01-01T00:00:00Z"),
+					// Verify roles are set
+					resource.TestCheckResourceAttr("iotcentral_api_token.test", "roles.#", "1"),
+					// Verify token is set
+					resource.TestCheckResourceAttrSet("iotcentral_api_token.test", "token"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "iotcentral_api_token.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token", "rotate_when_changed"},
+			},
+			// Rotation testing
+			{
+				Config: providerConfig + `
+				data "iotcentral_role" "test" {
+					display_name = "Operator"
+				}
+
+				resource "iotcentral_api_token" "test" {
+					id = "testapitoken"
+					expiry = "2030-01-01T00:00:00Z"
+					roles = [
+						{
+							role = data.iotcentral_role.test.id
+						}
+					]
+					rotate_when_changed = {
+						rotation = "2"
+					}
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify id is same
+					resource.TestCheckResourceAttr("iotcentral_api_token.test", "id", "testapitoken"),
+					// Verify rotate_when_changed is updated
+					resource.TestCheckResourceAttr("iotcentral_api_token.test", "rotate_when_changed.rotation", "2"),
+					// Verify a new token is set
+					resource.TestCheckResourceAttrSet("iotcentral_api_token.test", "token"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,412 @@
+package iotcentral
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &dataExportResource{}
+	_ resource.ResourceWithConfigure   = &dataExportResource{}
+	_ resource.ResourceWithImportState = &dataExportResource{}
+)
+
+// NewDataExportResource is a helper function to simplify the provider implementation.
+func NewDataExportResource() resource.Resource {
+	return &dataExportResource{}
+}
+
+// dataExportResource is the resource implementation.
+type dataExportResource struct {
+	client *iotcentral.Client
+}
+
+// dataExportResourceModel maps data export schema data.
+type dataExportResourceModel struct {
+	ID           types.String                         `tfsdk:"id"`
+	DisplayName  types.String                         `tfsdk:"display_name"`
+	Enabled      types.Bool                           `tfsdk:"enabled"`
+	Source       types.String                         `tfsdk:"source"`
+	Filter       types.String                         `tfsdk:"filter"`
+	Enrichments  map[string]dataExportEnrichmentModel `tfsdk:"enrichments"`
+	Destinations []dataExportDestinationModel         `tfsdk:"destinations"`
+	Status       types.String                         `tfsdk:"status"`
+	Timeouts     timeouts.Value                       `tfsdk:"timeouts"`
+}
+
+// dataExportEnrichmentModel maps data export enrichment schema data.
+type dataExportEnrichmentModel struct {
+	Value  types.String `tfsdk:"value"`
+	Target types.String `tfsdk:"target"`
+	Path   types.String `tfsdk:"path"`
+}
+
+// dataExportDestinationModel maps data export destination schema data.
+type dataExportDestinationModel struct {
+	ID        types.String `tfsdk:"id"`
+	Transform types.String `tfsdk:"transform"`
+}
+
+// Metadata returns the resource type name.
+func (r *dataExportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_export"
+}
+
+// Schema defines the schema for the resource.
+func (r *dataExportResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a data export that continuously exports data of the application to destinations.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique ID of the data export.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Description: "Display name of the data export.",
+				Required:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the data export is running. Defaults to `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"source": schema.StringAttribute{
+				Description: "The data to export, one of `telemetry`, `properties`, `deviceLifecycle`, " +
+					"`deviceTemplateLifecycle`, `deviceConnectivity` or `audit`. Changing the source creates a new data export.",
+				Required: true,
+				Validators: []validator.String{
+					oneOfValidator{values: dataExportSources},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"filter": schema.StringAttribute{
+				Description: "Query that selects the data to export, such as " +
+					"`SELECT * FROM dtmi:example:thermostat;1 WHERE temperature > 30`.",
+				Optional: true,
+				Validators: []validator.String{
+					queryValidator{},
+				},
+			},
+			"enrichments": schema.MapNestedAttribute{
+				Description: "Additional data to add to every exported message, by name. " +
+					"Either a static `value`, or the `path` of a property of the device, optionally for a `target` device template.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							Description: "Static value of the enrichment.",
+							Optional:    true,
+						},
+						"target": schema.StringAttribute{
+							Description: "ID of the device template that defines the property.",
+							Optional:    true,
+						},
+						"path": schema.StringAttribute{
+							Description: "Path of the property of the device, such as `$displayName` or the name of a property.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"destinations": schema.ListNestedAttribute{
+				Description: "List of destinations to export the data to.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the destination.",
+							Required:    true,
+						},
+						"transform": schema.StringAttribute{
+							Description: "JQ query that transforms the exported messages for this destination.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Health status of the data export.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dataExportResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*iotcentral.Client)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dataExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dataExportResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var exportID = plan.ID.ValueString()
+	var exportRequest = plan.toAPI()
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create new data export
+	export, err := putDataExport(ctx, r.client, exportID, exportRequest)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_data_export "+exportID, "create", createTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error creating data export",
+			"Could not create data export, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.fromAPI(export)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dataExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dataExportResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed data export value from IotCentral
+	export, err := getDataExport(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_data_export "+state.ID.ValueString(), "read", readTimeout) {
+			return
+		}
+
+		// Remove the resource from state when it was deleted outside Terraform
+		if isNotFound(err) {
+			tflog.Warn(ctx, "IotCentral data export not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading IotCentral Data Export",
+			"Could not read IotCentral data export ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.fromAPI(export)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dataExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan dataExportResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var exportID = plan.ID.ValueString()
+	var exportRequest = plan.toAPI()
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing data export
+	export, err := putDataExport(ctx, r.client, exportID, exportRequest)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_data_export "+exportID, "update", updateTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Updating IotCentral Data Export",
+			"Could not update data export, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated items
+	plan.fromAPI(export)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dataExportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dataExportResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing data export
+	err := deleteDataExport(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_data_export "+state.ID.ValueString(), "delete", deleteTimeout) {
+			return
+		}
+
+		// Nothing to delete when the resource is already gone
+		if isNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting IotCentral Data Export",
+			"Could not delete data export, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *dataExportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI generates the API request body of the data export.
+func (m *dataExportResourceModel) toAPI() dataExport {
+	request := dataExport{
+		DisplayName:  m.DisplayName.ValueString(),
+		Enabled:      m.Enabled.ValueBool(),
+		Source:       m.Source.ValueString(),
+		Filter:       m.Filter.ValueString(),
+		Enrichments:  map[string]dataExportEnrichment{},
+		Destinations: []dataExportDestination{},
+	}
+
+	for name, enrichment := range m.Enrichments {
+		request.Enrichments[name] = dataExportEnrichment{
+			Value:  enrichment.Value.ValueString(),
+			Target: enrichment.Target.ValueString(),
+			Path:   enrichment.Path.ValueString(),
+		}
+	}
+
+	for _, destination := range m.Destinations {
+		request.Destinations = append(request.Destinations, dataExportDestination{
+			ID:        destination.ID.ValueString(),
+			Transform: destination.Transform.ValueString(),
+		})
+	}
+
+	return request
+}
+
+// fromAPI maps a data export received from the API to the model.
+func (m *dataExportResourceModel) fromAPI(export *dataExport) {
+	m.ID = types.StringValue(export.ID)
+	m.DisplayName = types.StringValue(export.DisplayName)
+	m.Enabled = types.BoolValue(export.Enabled)
+	m.Source = types.StringValue(export.Source)
+	m.Filter = optionalStringValue(export.Filter)
+	m.Status = types.StringValue(export.Status)
+
+	// Keep unset enrichments unset when the data export has none
+	if len(export.Enrichments) > 0 || m.Enrichments != nil {
+		m.Enrichments = map[string]dataExportEnrichmentModel{}
+		for name, enrichment := range export.Enrichments {
+			m.Enrichments[name] = dataExportEnrichmentModel{
+				Value:  optionalStringValue(enrichment.Value),
+				Target: optionalStringValue(enrichment.Target),
+				Path:   optionalStringValue(enrichment.Path),
+			}
+		}
+	}
+
+	m.Destinations = []dataExportDestinationModel{}
+	for _, destination := range export.Destinations {
+		m.Destinations = append(m.Destinations, dataExportDestinationModel{
+			ID:        types.StringValue(destination.ID),
+			Transform: optionalStringValue(destination.Transform),
+		})
+	}
+}
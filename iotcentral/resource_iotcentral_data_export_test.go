@@ -0,0 +1,154 @@
+package iotcentral
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccDataExportUpdateConfig updates the data export and removes one of
+// its enrichments.
+const testAccDataExportUpdateConfig = `
+resource "iotcentral_destination" "test" {
+	id = "testexportdestination"
+	display_name = "Test export destination"
+
+	webhook {
+		url = "https://example.com/iotcentral"
+	}
+}
+
+resource "iotcentral_data_export" "test" {
+	id = "testdataexport"
+	display_name = "Test data export updated"
+	source = "deviceLifecycle"
+	enabled = false
+	enrichments = {
+		environment = {
+			value = "test"
+		}
+	}
+	destinations = [
+		{
+			id = iotcentral_destination.test.id
+			transform = "{ device: .device.id }"
+		}
+	]
+}
+`
+
+func TestAccIotCentralDataExportResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "iotcentral_destination" "test" {
+					id = "testexportdestination"
+					display_name = "Test export destination"
+
+					webhook {
+						url = "https://example.com/iotcentral"
+					}
+				}
+
+				resource "iotcentral_data_export" "test" {
+					id = "testdataexport"
+					display_name = "Test data export"
+					source = "deviceLifecycle"
+					enrichments = {
+						environment = {
+							value = "test"
+						}
+						site = {
+							value = "lab"
+						}
+					}
+					destinations = [
+						{
+							id = iotcentral_destination.test.id
+						}
+					]
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify id is set
+					resource.TestCheckResourceAttr("iotcentral_data_export.test", "id", "testdataexport"),
+					// Verify display_name is set
+					resource.TestCheckResourceAttr("iotcentral_data_export.test", "display_name", "Test data export"),
+					// Verify source is set
+					resource.TestCheckResourceAttr("iotcentral_data_export.test", "source", "deviceLifecycle"),
+					// Verify enabled defaults to true
+					resource.TestCheckResourceAttr("iotcentral_data_export.test", "enabled", "true"),
+					// Verify enrichments are set
+					resource.TestCheckResourceAttr("iotcentral_data_export.test", "enrichments.environment.value", "test"),
+					resource.TestCheckResourceAttr("iotcentral_data_export.test", "enrichments.site.value", "lab"),
+					// Verify destinations are set
+					resource.TestCheckResourceAttr("iotcentral_data_export.test", "destinations.#", "1"),
+					resource.TestCheckResourceAttr("iotcentral_data_export.test", "destinations.0.id", "testexportdestination"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "iotcentral_data_export.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"status"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccDataExportUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify display_name is updated
+					resource.TestCheckResourceAttr("iotcentral_data_export.test", "display_name", "Test data export updated"),
+					// Verify enabled is updated
+					resource.TestCheckResourceAttr("iotcentral_data_export.test", "enabled", "false"),
+					// Verify the removed enrichment is gone
+					resource.TestCheckResourceAttr("iotcentral_data_export.test", "enrichments.%", "1"),
+					resource.TestCheckNoResourceAttr("iotcentral_data_export.test", "enrichments.site.value"),
+					// Verify transform is updated
+					resource.TestCheckResourceAttr("iotcentral_data_export.test", "destinations.0.transform", "{ device: .device.id }"),
+				),
+			},
+			// Removed enrichments stay removed
+			{
+				Config:   providerConfig + testAccDataExportUpdateConfig,
+				PlanOnly: true,
+			},
+			// Removed settings return to their defaults
+			{
+				Config: providerConfig + `
+				resource "iotcentral_destination" "test" {
+					id = "testexportdestination"
+					display_name = "Test export destination"
+
+					webhook {
+						url = "https://example.com/iotcentral"
+					}
+				}
+
+				resource "iotcentral_data_export" "test" {
+					id = "testdataexport"
+					display_name = "Test data export updated"
+					source = "deviceLifecycle"
+					destinations = [
+						{
+							id = iotcentral_destination.test.id
+						}
+					]
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify enabled is back to its default
+					resource.TestCheckResourceAttr("iotcentral_data_export.test", "enabled", "true"),
+					// Verify enrichments are removed
+					resource.TestCheckNoResourceAttr("iotcentral_data_export.test", "enrichments.%"),
+					// Verify transform is removed
+					resource.TestCheckNoResourceAttr("iotcentral_data_export.test", "destinations.0.transform"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
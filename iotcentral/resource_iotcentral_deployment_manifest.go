@@ -0,0 +1,355 @@
+package iotcentral
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &deploymentManifestResource{}
+	_ resource.ResourceWithConfigure   = &deploymentManifestResource{}
+	_ resource.ResourceWithImportState = &deploymentManifestResource{}
+)
+
+// NewDeploymentManifestResource is a helper function to simplify the provider implementation.
+func NewDeploymentManifestResource() resource.Resource {
+	return &deploymentManifestResource{}
+}
+
+// deploymentManifestResource is the resource implementation.
+type deploymentManifestResource struct {
+	client *iotcentral.Client
+}
+
+// deploymentManifestResourceModel maps deployment manifest schema data.
+type deploymentManifestResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	DisplayName   types.String   `tfsdk:"display_name"`
+	Data          types.String   `tfsdk:"data"`
+	Organizations types.List     `tfsdk:"organizations"`
+	Etag          types.String   `tfsdk:"etag"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *deploymentManifestResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_manifest"
+}
+
+// Schema defines the schema for the resource.
+func (r *deploymentManifestResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a deployment manifest that configures the modules of IoT Edge devices. " +
+			"Deployment manifests are assigned to devices with `iotcentral_device_deployment_manifest_assignment`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique ID of the deployment manifest.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Description: "Display name of the deployment manifest.",
+				Required:    true,
+			},
+			"data": schema.StringAttribute{
+				Description: "The deployment manifest as a JSON document with the `modulesContent` of the IoT Edge modules, " +
+					"for example created with `jsonencode` or `file`. Semantically equal JSON does not cause a change.",
+				Required: true,
+				Validators: []validator.String{
+					jsonValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					suppressEquivalentJSON(),
+				},
+			},
+			"organizations": schema.ListAttribute{
+				Description: "List of IDs of the organizations the deployment manifest is available to.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"etag": schema.StringAttribute{
+				Description: "ETag of the deployment manifest, changed on every update.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *deploymentManifestResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*iotcentral.Client)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *deploymentManifestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan deploymentManifestResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var manifestID = plan.ID.ValueString()
+	manifestRequest, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create new deployment manifest
+	manifest, err := createDeploymentManifest(ctx, r.client, manifestID, manifestRequest)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_deployment_manifest "+manifestID, "create", createTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error creating deployment manifest",
+			"Could not create deployment manifest, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	diags = plan.fromAPI(ctx, manifest)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *deploymentManifestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state deploymentManifestResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed deployment manifest value from IotCentral
+	manifest, err := getDeploymentManifest(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_deployment_manifest "+state.ID.ValueString(), "read", readTimeout) {
+			return
+		}
+
+		// Remove the resource from state when it was deleted outside Terraform
+		if isNotFound(err) {
+			tflog.Warn(ctx, "IotCentral deployment manifest not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading IotCentral Deployment Manifest",
+			"Could not read IotCentral deployment manifest ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	diags = state.fromAPI(ctx, manifest)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *deploymentManifestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan deploymentManifestResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var manifestID = plan.ID.ValueString()
+	manifestRequest, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing deployment manifest
+	manifest, err := updateDeploymentManifest(ctx, r.client, manifestID, manifestRequest)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_deployment_manifest "+manifestID, "update", updateTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Updating IotCentral Deployment Manifest",
+			"Could not update deployment manifest, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated items
+	diags = plan.fromAPI(ctx, manifest)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *deploymentManifestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state deploymentManifestResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing deployment manifest
+	err := deleteDeploymentManifest(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_deployment_manifest "+state.ID.ValueString(), "delete", deleteTimeout) {
+			return
+		}
+
+		// Nothing to delete when the resource is already gone
+		if isNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting IotCentral Deployment Manifest",
+			"Could not delete deployment manifest, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *deploymentManifestResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI generates the API request body of the deployment manifest.
+func (m *deploymentManifestResourceModel) toAPI(ctx context.Context) (deploymentManifest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	request := deploymentManifest{
+		DisplayName:   m.DisplayName.ValueString(),
+		Data:          json.RawMessage(m.Data.ValueString()),
+		Organizations: []string{},
+	}
+
+	if !m.Organizations.IsNull() && !m.Organizations.IsUnknown() {
+		diags.Append(m.Organizations.ElementsAs(ctx, &request.Organizations, false)...)
+	}
+
+	return request, diags
+}
+
+// fromAPI maps a deployment manifest received from the API to the model. The
+// received data only replaces a semantically different value in state.
+func (m *deploymentManifestResourceModel) fromAPI(ctx context.Context, manifest *deploymentManifest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(manifest.ID)
+	m.DisplayName = types.StringValue(manifest.DisplayName)
+	m.Data = jsonStateValue(m.Data, manifest.Data)
+	m.Etag = types.StringValue(manifest.Etag)
+
+	// Keep an unset list unset when the deployment manifest belongs to no organization
+	if len(manifest.Organizations) > 0 || (!m.Organizations.IsNull() && !m.Organizations.IsUnknown()) {
+		organizationIDs := manifest.Organizations
+		if organizationIDs == nil {
+			organizationIDs = []string{}
+		}
+
+		organizations, d := types.ListValueFrom(ctx, types.StringType, organizationIDs)
+		diags.Append(d...)
+		m.Organizations = organizations
+	}
+
+	return diags
+}
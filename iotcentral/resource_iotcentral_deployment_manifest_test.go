@@ -0,0 +1,101 @@
+package iotcentral
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIotCentralDeploymentManifestResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "iotcentral_deployment_manifest" "test" {
+					id = "testdeploymentmanifest"
+					display_name = "Test deployment manifest"
+					data = jsonencode({
+						"modulesContent" = {
+							"$edgeAgent" = {
+								"properties.desired" = {
+									"schemaVersion" = "1.1"
+									"modules" = {}
+								}
+							}
+						}
+					})
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify id is set
+					resource.TestCheckResourceAttr("iotcentral_deployment_manifest.test", "id", "testdeploymentmanifest"),
+					// Verify display_name is set
+					resource.TestCheckResourceAttr("iotcentral_deployment_manifest.test", "display_name", "Test deployment manifest"),
+					// Verify etag is set
+					resource.TestCheckResourceAttrSet("iotcentral_deployment_manifest.test", "etag"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "iotcentral_deployment_manifest.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"data"},
+			},
+			// Semantically equal JSON testing
+			{
+				Config: providerConfig + `
+				resource "iotcentral_deployment_manifest" "test" {
+					id = "testdeploymentmanifest"
+					display_name = "Test deployment manifest"
+					data = <<-JSON
+						{
+							"modulesContent": {
+								"$edgeAgent": {
+									"properties.desired": { "modules": {}, "schemaVersion": "1.1" }
+								}
+							}
+						}
+					JSON
+				}
+`,
+				PlanOnly: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "iotcentral_deployment_manifest" "test" {
+					id = "testdeploymentmanifest"
+					display_name = "Test deployment manifest updated"
+					data = jsonencode({
+						"modulesContent" = {
+							"$edgeAgent" = {
+								"properties.desired" = {
+									"schemaVersion" = "1.1"
+									"modules" = {
+										"SimulatedTemperatureSensor" = {
+											"type" = "docker"
+											"status" = "running"
+											"restartPolicy" = "always"
+											"settings" = {
+												"image" = "mcr.microsoft.com/azureiotedge-simulated-temperature-sensor:1.0"
+											}
+										}
+									}
+								}
+							}
+						}
+					})
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify display_name is updated
+					resource.TestCheckResourceAttr("iotcentral_deployment_manifest.test", "display_name", "Test deployment manifest updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
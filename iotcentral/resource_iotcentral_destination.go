@@ -0,0 +1,802 @@
+package iotcentral
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &destinationResource{}
+	_ resource.ResourceWithConfigure      = &destinationResource{}
+	_ resource.ResourceWithImportState    = &destinationResource{}
+	_ resource.ResourceWithValidateConfig = &destinationResource{}
+)
+
+// destinationBlocks are the names of the destination kind blocks, of which
+// exactly one must be configured.
+var destinationBlocks = []string{"event_hubs", "service_bus_queue", "service_bus_topic", "blob_storage", "data_explorer", "webhook"}
+
+// NewDestinationResource is a helper function to simplify the provider implementation.
+func NewDestinationResource() resource.Resource {
+	return &destinationResource{}
+}
+
+// destinationResource is the resource implementation.
+type destinationResource struct {
+	client *iotcentral.Client
+}
+
+// destinationResourceModel maps destination schema data.
+type destinationResourceModel struct {
+	ID              types.String                     `tfsdk:"id"`
+	DisplayName     types.String                     `tfsdk:"display_name"`
+	EventHubs       *eventHubsDestinationModel       `tfsdk:"event_hubs"`
+	ServiceBusQueue *serviceBusQueueDestinationModel `tfsdk:"service_bus_queue"`
+	ServiceBusTopic *serviceBusTopicDestinationModel `tfsdk:"service_bus_topic"`
+	BlobStorage     *blobStorageDestinationModel     `tfsdk:"blob_storage"`
+	DataExplorer    *dataExplorerDestinationModel    `tfsdk:"data_explorer"`
+	Webhook         *webhookDestinationModel         `tfsdk:"webhook"`
+	Status          types.String                     `tfsdk:"status"`
+	Timeouts        timeouts.Value                   `tfsdk:"timeouts"`
+}
+
+// eventHubsDestinationModel maps Event Hubs destination schema data.
+type eventHubsDestinationModel struct {
+	ConnectionString types.String `tfsdk:"connection_string"`
+	HostName         types.String `tfsdk:"host_name"`
+	EventHubName     types.String `tfsdk:"event_hub_name"`
+}
+
+// serviceBusQueueDestinationModel maps Service Bus queue destination schema data.
+type serviceBusQueueDestinationModel struct {
+	ConnectionString types.String `tfsdk:"connection_string"`
+	HostName         types.String `tfsdk:"host_name"`
+	QueueName        types.String `tfsdk:"queue_name"`
+}
+
+// serviceBusTopicDestinationModel maps Service Bus topic destination schema data.
+type serviceBusTopicDestinationModel struct {
+	ConnectionString types.String `tfsdk:"connection_string"`
+	HostName         types.String `tfsdk:"host_name"`
+	TopicName        types.String `tfsdk:"topic_name"`
+}
+
+// blobStorageDestinationModel maps Blob Storage destination schema data.
+type blobStorageDestinationModel struct {
+	ConnectionString types.String `tfsdk:"connection_string"`
+	EndpointURI      types.String `tfsdk:"endpoint_uri"`
+	ContainerName    types.String `tfsdk:"container_name"`
+}
+
+// dataExplorerDestinationModel maps Azure Data Explorer destination schema data.
+type dataExplorerDestinationModel struct {
+	ClusterURL   types.String `tfsdk:"cluster_url"`
+	Database     types.String `tfsdk:"database"`
+	Table        types.String `tfsdk:"table"`
+	ClientID     types.String `tfsdk:"client_id"`
+	TenantID     types.String `tfsdk:"tenant_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+}
+
+// webhookDestinationModel maps webhook destination schema data.
+type webhookDestinationModel struct {
+	URL             types.String `tfsdk:"url"`
+	Headers         types.Map    `tfsdk:"headers"`
+	QueryParameters types.Map    `tfsdk:"query_parameters"`
+}
+
+// Metadata returns the resource type name.
+func (r *destinationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_destination"
+}
+
+// Schema defines the schema for the resource.
+func (r *destinationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Changing the kind of a destination requires a new destination
+	requiresReplaceOnKindChange := []planmodifier.Object{
+		objectplanmodifier.RequiresReplaceIf(
+			func(_ context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+				resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+			},
+			"Changing the kind of the destination creates a new destination.",
+			"Changing the kind of the destination creates a new destination.",
+		),
+	}
+
+	connectionStringAttribute := func(kind string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: "Connection string of the " + kind + ". Conflicts with managed identity authorization.",
+			Optional:    true,
+			Sensitive:   true,
+		}
+	}
+
+	hostNameAttribute := func(kind string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: "Host name of the " + kind + " namespace, such as `example.servicebus.windows.net`, " +
+				"to authorize with the system-assigned managed identity of the application.",
+			Optional: true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a data export destination. Exactly one of the destination kind blocks must be configured.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique ID of the destination.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Description: "Display name of the destination.",
+				Required:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Health status of the destination.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"event_hubs": schema.SingleNestedBlock{
+				Description:   "Exports to an Azure Event Hubs event hub.",
+				PlanModifiers: requiresReplaceOnKindChange,
+				Attributes: map[string]schema.Attribute{
+					"connection_string": connectionStringAttribute("Event Hubs namespace or event hub"),
+					"host_name":         hostNameAttribute("Event Hubs"),
+					"event_hub_name": schema.StringAttribute{
+						Description: "Name of the event hub. Required unless the connection string is scoped to the event hub.",
+						Optional:    true,
+					},
+				},
+			},
+			"service_bus_queue": schema.SingleNestedBlock{
+				Description:   "Exports to an Azure Service Bus queue.",
+				PlanModifiers: requiresReplaceOnKindChange,
+				Attributes: map[string]schema.Attribute{
+					"connection_string": connectionStringAttribute("Service Bus namespace or queue"),
+					"host_name":         hostNameAttribute("Service Bus"),
+					"queue_name": schema.StringAttribute{
+						Description: "Name of the queue. Required unless the connection string is scoped to the queue.",
+						Optional:    true,
+					},
+				},
+			},
+			"service_bus_topic": schema.SingleNestedBlock{
+				Description:   "Exports to an Azure Service Bus topic.",
+				PlanModifiers: requiresReplaceOnKindChange,
+				Attributes: map[string]schema.Attribute{
+					"connection_string": connectionStringAttribute("Service Bus namespace or topic"),
+					"host_name":         hostNameAttribute("Service Bus"),
+					"topic_name": schema.StringAttribute{
+						Description: "Name of the topic. Required unless the connection string is scoped to the topic.",
+						Optional:    true,
+					},
+				},
+			},
+			"blob_storage": schema.SingleNestedBlock{
+				Description:   "Exports to an Azure Blob Storage container.",
+				PlanModifiers: requiresReplaceOnKindChange,
+				Attributes: map[string]schema.Attribute{
+					"connection_string": connectionStringAttribute("storage account"),
+					"endpoint_uri": schema.StringAttribute{
+						Description: "Blob endpoint of the storage account, such as `https://example.blob.core.windows.net/`, " +
+							"to authorize with the system-assigned managed identity of the application.",
+						Optional: true,
+					},
+					"container_name": schema.StringAttribute{
+						Description: "Name of the container.",
+						Optional:    true,
+					},
+				},
+			},
+			"data_explorer": schema.SingleNestedBlock{
+				Description: "Exports to an Azure Data Explorer table. Authorizes with a service principal when `client_id`, " +
+					"`tenant_id` and `client_secret` are set, otherwise with the system-assigned managed identity of the application.",
+				PlanModifiers: requiresReplaceOnKindChange,
+				Attributes: map[string]schema.Attribute{
+					"cluster_url": schema.StringAttribute{
+						Description: "URL of the cluster, such as `https://example.westeurope.kusto.windows.net`.",
+						Optional:    true,
+					},
+					"database": schema.StringAttribute{
+						Description: "Name of the database.",
+						Optional:    true,
+					},
+					"table": schema.StringAttribute{
+						Description: "Name of the table.",
+						Optional:    true,
+					},
+					"client_id": schema.StringAttribute{
+						Description: "Client ID of the service principal.",
+						Optional:    true,
+					},
+					"tenant_id": schema.StringAttribute{
+						Description: "Tenant ID of the service principal.",
+						Optional:    true,
+					},
+					"client_secret": schema.StringAttribute{
+						Description: "Client secret of the service principal.",
+						Optional:    true,
+						Sensitive:   true,
+					},
+				},
+			},
+			"webhook": schema.SingleNestedBlock{
+				Description:   "Exports to a webhook.",
+				PlanModifiers: requiresReplaceOnKindChange,
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						Description: "URL of the webhook.",
+						Optional:    true,
+					},
+					"headers": schema.MapAttribute{
+						Description: "Headers to add to every request. Header values are stored as secrets by IotCentral, so only changes to the header names are detected.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"query_parameters": schema.MapAttribute{
+						Description: "Query parameters to add to every request.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// ValidateConfig validates that exactly one destination kind is configured
+// with the attributes its authorization requires. Attributes of a single
+// nested block cannot be required in the schema, as the block is optional.
+func (r *destinationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg destinationResourceModel
+	diags := req.Config.Get(ctx, &cfg)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configured := 0
+	for _, set := range []bool{cfg.EventHubs != nil, cfg.ServiceBusQueue != nil, cfg.ServiceBusTopic != nil, cfg.BlobStorage != nil, cfg.DataExplorer != nil, cfg.Webhook != nil} {
+		if set {
+			configured++
+		}
+	}
+
+	if configured != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid Destination Configuration",
+			"Exactly one of the "+strings.Join(destinationBlocks, ", ")+" blocks must be configured.",
+		)
+		return
+	}
+
+	switch {
+	case cfg.EventHubs != nil:
+		validateExactlyOneOf(&resp.Diagnostics, path.Root("event_hubs"),
+			map[string]types.String{"connection_string": cfg.EventHubs.ConnectionString, "host_name": cfg.EventHubs.HostName})
+		if !cfg.EventHubs.HostName.IsNull() {
+			validateRequired(&resp.Diagnostics, path.Root("event_hubs").AtName("event_hub_name"), cfg.EventHubs.EventHubName, "with host_name")
+		}
+	case cfg.ServiceBusQueue != nil:
+		validateExactlyOneOf(&resp.Diagnostics, path.Root("service_bus_queue"),
+			map[string]types.String{"connection_string": cfg.ServiceBusQueue.ConnectionString, "host_name": cfg.ServiceBusQueue.HostName})
+		if !cfg.ServiceBusQueue.HostName.IsNull() {
+			validateRequired(&resp.Diagnostics, path.Root("service_bus_queue").AtName("queue_name"), cfg.ServiceBusQueue.QueueName, "with host_name")
+		}
+	case cfg.ServiceBusTopic != nil:
+		validateExactlyOneOf(&resp.Diagnostics, path.Root("service_bus_topic"),
+			map[string]types.String{"connection_string": cfg.ServiceBusTopic.ConnectionString, "host_name": cfg.ServiceBusTopic.HostName})
+		if !cfg.ServiceBusTopic.HostName.IsNull() {
+			validateRequired(&resp.Diagnostics, path.Root("service_bus_topic").AtName("topic_name"), cfg.ServiceBusTopic.TopicName, "with host_name")
+		}
+	case cfg.BlobStorage != nil:
+		validateExactlyOneOf(&resp.Diagnostics, path.Root("blob_storage"),
+			map[string]types.String{"connection_string": cfg.BlobStorage.ConnectionString, "endpoint_uri": cfg.BlobStorage.EndpointURI})
+		validateRequired(&resp.Diagnostics, path.Root("blob_storage").AtName("container_name"), cfg.BlobStorage.ContainerName, "")
+	case cfg.DataExplorer != nil:
+		validateRequired(&resp.Diagnostics, path.Root("data_explorer").AtName("cluster_url"), cfg.DataExplorer.ClusterURL, "")
+		validateRequired(&resp.Diagnostics, path.Root("data_explorer").AtName("database"), cfg.DataExplorer.Database, "")
+		validateRequired(&resp.Diagnostics, path.Root("data_explorer").AtName("table"), cfg.DataExplorer.Table, "")
+
+		// A service principal needs all of its attributes
+		if !cfg.DataExplorer.ClientID.IsNull() || !cfg.DataExplorer.TenantID.IsNull() || !cfg.DataExplorer.ClientSecret.IsNull() {
+			validateRequired(&resp.Diagnostics, path.Root("data_explorer").AtName("client_id"), cfg.DataExplorer.ClientID, "for service principal authorization")
+			validateRequired(&resp.Diagnostics, path.Root("data_explorer").AtName("tenant_id"), cfg.DataExplorer.TenantID, "for service principal authorization")
+			validateRequired(&resp.Diagnostics, path.Root("data_explorer").AtName("client_secret"), cfg.DataExplorer.ClientSecret, "for service principal authorization")
+		}
+	case cfg.Webhook != nil:
+		validateRequired(&resp.Diagnostics, path.Root("webhook").AtName("url"), cfg.Webhook.URL, "")
+	}
+}
+
+// validateExactlyOneOf adds an error unless exactly one of the attributes of
+// a block is set. Unknown values are assumed to be set.
+func validateExactlyOneOf(diags *diag.Diagnostics, block path.Path, attributes map[string]types.String) {
+	var names []string
+	set := 0
+	for name, value := range attributes {
+		names = append(names, name)
+		if !value.IsNull() {
+			set++
+		}
+	}
+
+	if set != 1 {
+		sort.Strings(names)
+		diags.AddAttributeError(
+			block,
+			"Invalid Destination Configuration",
+			"Exactly one of "+strings.Join(names, " or ")+" must be set in "+block.String()+".",
+		)
+	}
+}
+
+// validateRequired adds an error when a required block attribute is not set.
+func validateRequired(diags *diag.Diagnostics, attribute path.Path, value types.String, condition string) {
+	if !value.IsNull() {
+		return
+	}
+
+	detail := "The attribute " + attribute.String() + " is required"
+	if condition != "" {
+		detail += " " + condition
+	}
+
+	diags.AddAttributeError(attribute, "Missing Required Attribute", detail+".")
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *destinationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*iotcentral.Client)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *destinationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan destinationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var destinationID = plan.ID.ValueString()
+	destinationRequest, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create new destination
+	destination, err := putDestination(ctx, r.client, destinationID, destinationRequest)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_destination "+destinationID, "create", createTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error creating destination",
+			"Could not create destination, unexpected error: "+redactSecrets(err.Error(), destinationRequest.secrets()...),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	diags = plan.fromAPI(ctx, destination)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *destinationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state destinationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed destination value from IotCentral
+	destination, err := getDestination(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_destination "+state.ID.ValueString(), "read", readTimeout) {
+			return
+		}
+
+		// Remove the resource from state when it was deleted outside Terraform
+		if isNotFound(err) {
+			tflog.Warn(ctx, "IotCentral destination not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		stateRequest, _ := state.toAPI(ctx)
+		resp.Diagnostics.AddError(
+			"Error Reading IotCentral Destination",
+			"Could not read IotCentral destination ID "+state.ID.ValueString()+": "+redactSecrets(err.Error(), stateRequest.secrets()...),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	diags = state.fromAPI(ctx, destination)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *destinationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan destinationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var destinationID = plan.ID.ValueString()
+	destinationRequest, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing destination
+	destination, err := putDestination(ctx, r.client, destinationID, destinationRequest)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_destination "+destinationID, "update", updateTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Updating IotCentral Destination",
+			"Could not update destination, unexpected error: "+redactSecrets(err.Error(), destinationRequest.secrets()...),
+		)
+		return
+	}
+
+	// Update resource state with updated items
+	diags = plan.fromAPI(ctx, destination)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *destinationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state destinationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing destination
+	err := deleteDestination(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_destination "+state.ID.ValueString(), "delete", deleteTimeout) {
+			return
+		}
+
+		// Nothing to delete when the resource is already gone
+		if isNotFound(err) {
+			return
+		}
+
+		stateRequest, _ := state.toAPI(ctx)
+		resp.Diagnostics.AddError(
+			"Error Deleting IotCentral Destination",
+			"Could not delete destination, unexpected error: "+redactSecrets(err.Error(), stateRequest.secrets()...),
+		)
+		return
+	}
+}
+
+func (r *destinationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI generates the API request body of the destination.
+func (m *destinationResourceModel) toAPI(ctx context.Context) (destination, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	request := destination{
+		DisplayName: m.DisplayName.ValueString(),
+	}
+
+	switch {
+	case m.EventHubs != nil:
+		request.Type = destinationTypeEventHubs
+		request.Authorization = connectionAuthorization(m.EventHubs.ConnectionString, m.EventHubs.HostName)
+		request.Authorization.EventHubName = m.EventHubs.EventHubName.ValueString()
+	case m.ServiceBusQueue != nil:
+		request.Type = destinationTypeServiceBusQueue
+		request.Authorization = connectionAuthorization(m.ServiceBusQueue.ConnectionString, m.ServiceBusQueue.HostName)
+		request.Authorization.QueueName = m.ServiceBusQueue.QueueName.ValueString()
+	case m.ServiceBusTopic != nil:
+		request.Type = destinationTypeServiceBusTopic
+		request.Authorization = connectionAuthorization(m.ServiceBusTopic.ConnectionString, m.ServiceBusTopic.HostName)
+		request.Authorization.TopicName = m.ServiceBusTopic.TopicName.ValueString()
+	case m.BlobStorage != nil:
+		request.Type = destinationTypeBlobStorage
+		request.Authorization = &destinationAuthorization{
+			Type:          destinationAuthManagedIdentity,
+			EndpointURI:   m.BlobStorage.EndpointURI.ValueString(),
+			ContainerName: m.BlobStorage.ContainerName.ValueString(),
+		}
+
+		if !m.BlobStorage.ConnectionString.IsNull() {
+			request.Authorization.Type = destinationAuthConnectionString
+			request.Authorization.ConnectionString = m.BlobStorage.ConnectionString.ValueString()
+		}
+	case m.DataExplorer != nil:
+		request.Type = destinationTypeDataExplorer
+		request.ClusterURL = m.DataExplorer.ClusterURL.ValueString()
+		request.Database = m.DataExplorer.Database.ValueString()
+		request.Table = m.DataExplorer.Table.ValueString()
+		request.Authorization = &destinationAuthorization{
+			Type: destinationAuthManagedIdentity,
+		}
+
+		if !m.DataExplorer.ClientID.IsNull() {
+			request.Authorization = &destinationAuthorization{
+				Type:         destinationAuthServicePrincipal,
+				ClientID:     m.DataExplorer.ClientID.ValueString(),
+				TenantID:     m.DataExplorer.TenantID.ValueString(),
+				ClientSecret: m.DataExplorer.ClientSecret.ValueString(),
+			}
+		}
+	case m.Webhook != nil:
+		request.Type = destinationTypeWebhook
+		request.URL = m.Webhook.URL.ValueString()
+		request.HeaderCustomizations = map[string]destinationCustomization{}
+		request.QueryCustomizations = map[string]destinationCustomization{}
+
+		var headers, queryParameters map[string]string
+		if !m.Webhook.Headers.IsNull() && !m.Webhook.Headers.IsUnknown() {
+			diags.Append(m.Webhook.Headers.ElementsAs(ctx, &headers, false)...)
+		}
+
+		if !m.Webhook.QueryParameters.IsNull() && !m.Webhook.QueryParameters.IsUnknown() {
+			diags.Append(m.Webhook.QueryParameters.ElementsAs(ctx, &queryParameters, false)...)
+		}
+
+		for name, value := range headers {
+			request.HeaderCustomizations[name] = destinationCustomization{Value: value, Secret: true}
+		}
+
+		for name, value := range queryParameters {
+			request.QueryCustomizations[name] = destinationCustomization{Value: value}
+		}
+	}
+
+	return request, diags
+}
+
+// connectionAuthorization returns the authorization of a messaging
+// destination, using the connection string when it is set and the managed
+// identity of the application otherwise.
+func connectionAuthorization(connectionString, hostName types.String) *destinationAuthorization {
+	if !connectionString.IsNull() {
+		return &destinationAuthorization{
+			Type:             destinationAuthConnectionString,
+			ConnectionString: connectionString.ValueString(),
+		}
+	}
+
+	return &destinationAuthorization{
+		Type:     destinationAuthManagedIdentity,
+		HostName: hostName.ValueString(),
+	}
+}
+
+// secrets returns the secret values of the destination request.
+func (d destination) secrets() []string {
+	var secrets []string
+	if d.Authorization != nil {
+		secrets = append(secrets, d.Authorization.ConnectionString, d.Authorization.ClientSecret)
+	}
+
+	for _, header := range d.HeaderCustomizations {
+		secrets = append(secrets, header.Value)
+	}
+
+	return secrets
+}
+
+// fromAPI maps a destination received from the API to the model. Secrets are
+// never returned by the API, so the values known to Terraform are kept.
+func (m *destinationResourceModel) fromAPI(ctx context.Context, destination *destination) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(destination.ID)
+	m.DisplayName = types.StringValue(destination.DisplayName)
+	m.Status = types.StringValue(destination.Status)
+
+	auth := destination.Authorization
+	if auth == nil {
+		auth = &destinationAuthorization{}
+	}
+
+	// Authorization attributes are only refreshed for managed identities, as
+	// the entity of a connection string authorization may be part of the
+	// connection string itself
+	managedIdentity := auth.Type == destinationAuthManagedIdentity
+
+	switch destination.Type {
+	case destinationTypeEventHubs:
+		if m.EventHubs == nil {
+			m.EventHubs = &eventHubsDestinationModel{}
+		}
+
+		if managedIdentity {
+			m.EventHubs.HostName = optionalStringValue(auth.HostName)
+			m.EventHubs.EventHubName = optionalStringValue(auth.EventHubName)
+		}
+	case destinationTypeServiceBusQueue:
+		if m.ServiceBusQueue == nil {
+			m.ServiceBusQueue = &serviceBusQueueDestinationModel{}
+		}
+
+		if managedIdentity {
+			m.ServiceBusQueue.HostName = optionalStringValue(auth.HostName)
+			m.ServiceBusQueue.QueueName = optionalStringValue(auth.QueueName)
+		}
+	case destinationTypeServiceBusTopic:
+		if m.ServiceBusTopic == nil {
+			m.ServiceBusTopic = &serviceBusTopicDestinationModel{}
+		}
+
+		if managedIdentity {
+			m.ServiceBusTopic.HostName = optionalStringValue(auth.HostName)
+			m.ServiceBusTopic.TopicName = optionalStringValue(auth.TopicName)
+		}
+	case destinationTypeBlobStorage:
+		if m.BlobStorage == nil {
+			m.BlobStorage = &blobStorageDestinationModel{}
+		}
+
+		m.BlobStorage.EndpointURI = optionalStringValue(auth.EndpointURI)
+		m.BlobStorage.ContainerName = optionalStringValue(auth.ContainerName)
+	case destinationTypeDataExplorer:
+		if m.DataExplorer == nil {
+			m.DataExplorer = &dataExplorerDestinationModel{}
+		}
+
+		m.DataExplorer.ClusterURL = types.StringValue(destination.ClusterURL)
+		m.DataExplorer.Database = types.StringValue(destination.Database)
+		m.DataExplorer.Table = types.StringValue(destination.Table)
+		m.DataExplorer.ClientID = optionalStringValue(auth.ClientID)
+		m.DataExplorer.TenantID = optionalStringValue(auth.TenantID)
+	case destinationTypeWebhook:
+		if m.Webhook == nil {
+			m.Webhook = &webhookDestinationModel{
+				Headers:         types.MapNull(types.StringType),
+				QueryParameters: types.MapNull(types.StringType),
+			}
+		}
+
+		m.Webhook.URL = types.StringValue(destination.URL)
+
+		// Header values are secrets, so only the header names are refreshed.
+		// Headers added outside Terraform get an empty value.
+		if len(destination.HeaderCustomizations) > 0 || !m.Webhook.Headers.IsNull() {
+			known := map[string]string{}
+			if !m.Webhook.Headers.IsNull() && !m.Webhook.Headers.IsUnknown() {
+				diags.Append(m.Webhook.Headers.ElementsAs(ctx, &known, false)...)
+			}
+
+			headers := map[string]string{}
+			for name := range destination.HeaderCustomizations {
+				headers[name] = known[name]
+			}
+
+			value, d := types.MapValueFrom(ctx, types.StringType, headers)
+			diags.Append(d...)
+			m.Webhook.Headers = value
+		}
+
+		// Keep unset query parameters unset when the webhook has none
+		if len(destination.QueryCustomizations) > 0 || !m.Webhook.QueryParameters.IsNull() {
+			queryParameters := map[string]string{}
+			for name, parameter := range destination.QueryCustomizations {
+				queryParameters[name] = parameter.Value
+			}
+
+			value, d := types.MapValueFrom(ctx, types.StringType, queryParameters)
+			diags.Append(d...)
+			m.Webhook.QueryParameters = value
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,107 @@
+package iotcentral
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIotCentralDestinationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Configuration validation testing
+			{
+				Config: providerConfig + `
+				resource "iotcentral_destination" "test" {
+					id = "testdestination"
+					display_name = "Test destination"
+				}
+`,
+				ExpectError: regexp.MustCompile(`Exactly one of the event_hubs`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "iotcentral_destination" "test" {
+					id = "testdestination"
+					display_name = "Test destination"
+
+					webhook {
+						url = "https://example.com/iotcentral"
+						headers = {
+							"X-Api-Key" = "secret"
+						}
+						query_parameters = {
+							source = "terraform"
+						}
+					}
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify id is set
+					resource.TestCheckResourceAttr("iotcentral_destination.test", "id", "testdestination"),
+					// Verify display_name is set
+					resource.TestCheckResourceAttr("iotcentral_destination.test", "display_name", "Test destination"),
+					// Verify webhook is set
+					resource.TestCheckResourceAttr("iotcentral_destination.test", "webhook.url", "https://example.com/iotcentral"),
+					resource.TestCheckResourceAttr("iotcentral_destination.test", "webhook.query_parameters.source", "terraform"),
+					// Verify status is set
+					resource.TestCheckResourceAttrSet("iotcentral_destination.test", "status"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "iotcentral_destination.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"webhook.headers", "status"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "iotcentral_destination" "test" {
+					id = "testdestination"
+					display_name = "Test destination updated"
+
+					webhook {
+						url = "https://example.com/iotcentral/updated"
+						headers = {
+							"X-Api-Key" = "secret"
+						}
+					}
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify id is same
+					resource.TestCheckResourceAttr("iotcentral_destination.test", "id", "testdestination"),
+					// Verify display_name is updated
+					resource.TestCheckResourceAttr("iotcentral_destination.test", "display_name", "Test destination updated"),
+					// Verify url is updated
+					resource.TestCheckResourceAttr("iotcentral_destination.test", "webhook.url", "https://example.com/iotcentral/updated"),
+					// Verify query_parameters are removed
+					resource.TestCheckNoResourceAttr("iotcentral_destination.test", "webhook.query_parameters.%"),
+				),
+			},
+			// Removed headers are removed from the destination
+			{
+				Config: providerConfig + `
+				resource "iotcentral_destination" "test" {
+					id = "testdestination"
+					display_name = "Test destination updated"
+
+					webhook {
+						url = "https://example.com/iotcentral/updated"
+					}
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify headers are removed
+					resource.TestCheckNoResourceAttr("iotcentral_destination.test", "webhook.headers.%"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
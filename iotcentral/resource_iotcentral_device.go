@@ -0,0 +1,388 @@
+package iotcentral
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &deviceResource{}
+	_ resource.ResourceWithConfigure   = &deviceResource{}
+	_ resource.ResourceWithImportState = &deviceResource{}
+)
+
+// NewDeviceResource is a helper function to simplify the provider implementation.
+func NewDeviceResource() resource.Resource {
+	return &deviceResource{}
+}
+
+// deviceResource is the resource implementation.
+type deviceResource struct {
+	client *iotcentral.Client
+}
+
+// deviceResourceModel maps device schema data.
+type deviceResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	DisplayName   types.String   `tfsdk:"display_name"`
+	Template      types.String   `tfsdk:"template"`
+	Simulated     types.Bool     `tfsdk:"simulated"`
+	Enabled       types.Bool     `tfsdk:"enabled"`
+	Organizations types.List     `tfsdk:"organizations"`
+	Provisioned   types.Bool     `tfsdk:"provisioned"`
+	Etag          types.String   `tfsdk:"etag"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *deviceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device"
+}
+
+// Schema defines the schema for the resource.
+func (r *deviceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a device.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique ID of the device.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Description: "Display name of the device.",
+				Required:    true,
+			},
+			"template": schema.StringAttribute{
+				Description: "ID of the device template the device is assigned to. Changing the template migrates the device. " +
+					"Devices cannot be unassigned from their template, so removing it creates a new device.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						templateRemoved,
+						"Removing the template requires a new device.",
+						"Removing the template requires a new device.",
+					),
+				},
+			},
+			"simulated": schema.BoolAttribute{
+				Description: "Whether the device is simulated. Simulated devices require a `template`. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the device is allowed to connect to the application. Defaults to `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"organizations": schema.ListAttribute{
+				Description: "List of IDs of the organizations the device belongs to.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"provisioned": schema.BoolAttribute{
+				Description: "Whether the device has been provisioned.",
+				Computed:    true,
+			},
+			"etag": schema.StringAttribute{
+				Description: "ETag of the device, changed on every update.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *deviceResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*iotcentral.Client)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *deviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan deviceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var deviceID = plan.ID.ValueString()
+	deviceRequest, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create new device
+	device, err := createDevice(ctx, r.client, deviceID, deviceRequest)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_device "+deviceID, "create", createTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error creating device",
+			"Could not create device, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	diags = plan.fromAPI(ctx, device)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *deviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state deviceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed device value from IotCentral
+	device, err := getDevice(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_device "+state.ID.ValueString(), "read", readTimeout) {
+			return
+		}
+
+		// Remove the resource from state when it was deleted outside Terraform
+		if isNotFound(err) {
+			tflog.Warn(ctx, "IotCentral device not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading IotCentral Device",
+			"Could not read IotCentral device ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	diags = state.fromAPI(ctx, device)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *deviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan deviceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var deviceID = plan.ID.ValueString()
+	deviceRequest, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing device
+	device, err := updateDevice(ctx, r.client, deviceID, deviceRequest)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_device "+deviceID, "update", updateTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Updating IotCentral Device",
+			"Could not update device, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated items
+	diags = plan.fromAPI(ctx, device)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *deviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state deviceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing device
+	err := deleteDevice(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_device "+state.ID.ValueString(), "delete", deleteTimeout) {
+			return
+		}
+
+		// Nothing to delete when the resource is already gone
+		if isNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting IotCentral Device",
+			"Could not delete device, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *deviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI generates the API request body of the device.
+func (m *deviceResourceModel) toAPI(ctx context.Context) (device, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	request := device{
+		DisplayName:   m.DisplayName.ValueString(),
+		Template:      m.Template.ValueString(),
+		Simulated:     m.Simulated.ValueBool(),
+		Enabled:       m.Enabled.ValueBool(),
+		Organizations: []string{},
+	}
+
+	if !m.Organizations.IsNull() && !m.Organizations.IsUnknown() {
+		diags.Append(m.Organizations.ElementsAs(ctx, &request.Organizations, false)...)
+	}
+
+	return request, diags
+}
+
+// fromAPI maps a device received from the API to the model.
+func (m *deviceResourceModel) fromAPI(ctx context.Context, device *device) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(device.ID)
+	m.DisplayName = types.StringValue(device.DisplayName)
+	m.Simulated = types.BoolValue(device.Simulated)
+	m.Enabled = types.BoolValue(device.Enabled)
+	m.Provisioned = types.BoolValue(device.Provisioned)
+	m.Etag = types.StringValue(device.Etag)
+
+	if device.Template != "" {
+		m.Template = types.StringValue(device.Template)
+	} else {
+		m.Template = types.StringNull()
+	}
+
+	// Keep an unset list unset when the device belongs to no organization
+	if len(device.Organizations) > 0 || (!m.Organizations.IsNull() && !m.Organizations.IsUnknown()) {
+		organizationIDs := device.Organizations
+		if organizationIDs == nil {
+			organizationIDs = []string{}
+		}
+
+		organizations, d := types.ListValueFrom(ctx, types.StringType, organizationIDs)
+		diags.Append(d...)
+		m.Organizations = organizations
+	}
+
+	return diags
+}
+
+// templateRemoved requires a new device when the template is removed from the
+// configuration, as the API keeps the template of a device once it is set.
+func templateRemoved(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull() && req.PlanValue.IsNull()
+}
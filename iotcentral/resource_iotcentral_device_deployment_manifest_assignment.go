@@ -0,0 +1,331 @@
+package iotcentral
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &deviceDeploymentManifestAssignmentResource{}
+	_ resource.ResourceWithConfigure   = &deviceDeploymentManifestAssignmentResource{}
+	_ resource.ResourceWithImportState = &deviceDeploymentManifestAssignmentResource{}
+)
+
+// NewDeviceDeploymentManifestAssignmentResource is a helper function to simplify the provider implementation.
+func NewDeviceDeploymentManifestAssignmentResource() resource.Resource {
+	return &deviceDeploymentManifestAssignmentResource{}
+}
+
+// deviceDeploymentManifestAssignmentResource is the resource implementation.
+type deviceDeploymentManifestAssignmentResource struct {
+	client *iotcentral.Client
+}
+
+// deviceDeploymentManifestAssignmentResourceModel maps device deployment manifest assignment schema data.
+type deviceDeploymentManifestAssignmentResourceModel struct {
+	ID                     types.String   `tfsdk:"id"`
+	DeviceID               types.String   `tfsdk:"device_id"`
+	DeploymentManifestID   types.String   `tfsdk:"deployment_manifest_id"`
+	DeploymentManifestEtag types.String   `tfsdk:"deployment_manifest_etag"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *deviceDeploymentManifestAssignmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_deployment_manifest_assignment"
+}
+
+// Schema defines the schema for the resource.
+func (r *deviceDeploymentManifestAssignmentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Assigns a deployment manifest to an IoT Edge device and applies its modules. " +
+			"Set `deployment_manifest_etag` to the `etag` of the manifest to apply it again whenever it changes. " +
+			"Destroying the assignment leaves the modules of the last applied manifest on the device.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the assignment, the same as the device ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_id": schema.StringAttribute{
+				Description: "ID of the IoT Edge device.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deployment_manifest_id": schema.StringAttribute{
+				Description: "ID of the deployment manifest to apply to the device.",
+				Required:    true,
+			},
+			"deployment_manifest_etag": schema.StringAttribute{
+				Description: "ETag of the applied deployment manifest. Set it to the `etag` of the `iotcentral_deployment_manifest` " +
+					"to apply the manifest again whenever it changes. Defaults to the ETag of the manifest when it was applied.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					keepEtagForSameManifest(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *deviceDeploymentManifestAssignmentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*iotcentral.Client)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *deviceDeploymentManifestAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan deviceDeploymentManifestAssignmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var deviceID = plan.DeviceID.ValueString()
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Apply the deployment manifest to the device
+	err := r.apply(ctx, &plan)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_device_deployment_manifest_assignment "+deviceID, "create", createTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error creating device deployment manifest assignment",
+			"Could not apply deployment manifest to device "+deviceID+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *deviceDeploymentManifestAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state deviceDeploymentManifestAssignmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the deployment manifest currently assigned to the device
+	manifest, err := getDeviceDeploymentManifest(ctx, r.client, state.DeviceID.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_device_deployment_manifest_assignment "+state.DeviceID.ValueString(), "read", readTimeout) {
+			return
+		}
+
+		// Remove the resource from state when the device was deleted outside Terraform
+		if isNotFound(err) {
+			tflog.Warn(ctx, "IotCentral device not found, removing deployment manifest assignment from state", map[string]any{"id": state.DeviceID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading IotCentral Device Deployment Manifest Assignment",
+			"Could not read IotCentral device ID "+state.DeviceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Remove the resource from state when the manifest was unassigned outside Terraform
+	if manifest == nil || manifest.ID == "" {
+		tflog.Warn(ctx, "IotCentral device has no deployment manifest, removing assignment from state", map[string]any{"id": state.DeviceID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.ID = state.DeviceID
+	state.DeploymentManifestID = types.StringValue(manifest.ID)
+
+	// Imported assignments take the current ETag, since the applied one is unknown
+	if state.DeploymentManifestEtag.IsNull() {
+		current, err := getDeploymentManifest(ctx, r.client, manifest.ID)
+		if err != nil && !isNotFound(err) {
+			if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_device_deployment_manifest_assignment "+state.DeviceID.ValueString(), "read", readTimeout) {
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Reading IotCentral Device Deployment Manifest Assignment",
+				"Could not read IotCentral deployment manifest ID "+manifest.ID+": "+err.Error(),
+			)
+			return
+		}
+
+		if current != nil {
+			state.DeploymentManifestEtag = types.StringValue(current.Etag)
+		}
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *deviceDeploymentManifestAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan deviceDeploymentManifestAssignmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var deviceID = plan.DeviceID.ValueString()
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Apply the new deployment manifest to the device
+	err := r.apply(ctx, &plan)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_device_deployment_manifest_assignment "+deviceID, "update", updateTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Updating IotCentral Device Deployment Manifest Assignment",
+			"Could not apply deployment manifest to device "+deviceID+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the Terraform state. The API cannot unassign a deployment
+// manifest, so the device keeps its modules.
+func (r *deviceDeploymentManifestAssignmentResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removing IotCentral device deployment manifest assignment from state only")
+}
+
+func (r *deviceDeploymentManifestAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id and device_id attributes
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("device_id"), req.ID)...)
+}
+
+// apply applies the planned deployment manifest to the device.
+func (r *deviceDeploymentManifestAssignmentResource) apply(ctx context.Context, m *deviceDeploymentManifestAssignmentResourceModel) error {
+	manifest, err := getDeploymentManifest(ctx, r.client, m.DeploymentManifestID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	err = applyDeviceDeploymentManifest(ctx, r.client, m.DeviceID.ValueString(), *manifest)
+	if err != nil {
+		return err
+	}
+
+	m.ID = m.DeviceID
+
+	// A configured ETag is kept, so the state matches the configuration
+	if m.DeploymentManifestEtag.IsUnknown() {
+		m.DeploymentManifestEtag = types.StringValue(manifest.Etag)
+	}
+
+	return nil
+}
+
+// keepEtagForSameManifest returns a plan modifier that keeps the applied ETag
+// when it is not configured and the deployment manifest does not change.
+func keepEtagForSameManifest() planmodifier.String {
+	return keepEtagForSameManifestModifier{}
+}
+
+// keepEtagForSameManifestModifier implements the plan modifier.
+type keepEtagForSameManifestModifier struct{}
+
+// Description returns a human-readable description of the plan modifier.
+func (m keepEtagForSameManifestModifier) Description(_ context.Context) string {
+	return "The ETag only changes when another deployment manifest is applied."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m keepEtagForSameManifestModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString keeps the prior ETag in the plan.
+func (m keepEtagForSameManifestModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.ConfigValue.IsNull() || req.StateValue.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var planned, prior types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("deployment_manifest_id"), &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deployment_manifest_id"), &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if planned.Equal(prior) {
+		resp.PlanValue = req.StateValue
+	}
+}
@@ -0,0 +1,115 @@
+package iotcentral
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccDeviceDeploymentManifestAssignmentConfig creates an IoT Edge device
+// and a deployment manifest to assign to it.
+const testAccDeviceDeploymentManifestAssignmentConfig = `
+resource "iotcentral_device_template" "test" {
+	id = "dtmi:terraform:test:gateway;1"
+	display_name = "Gateway"
+	types = ["ModelDefinition", "DeviceModel", "EdgeModel"]
+	capability_model = jsonencode({
+		"@id" = "dtmi:terraform:test:gateway:model;1"
+		"@type" = "Interface"
+		"displayName" = "Gateway"
+		"contents" = []
+	})
+}
+
+resource "iotcentral_device" "test" {
+	id = "testgateway"
+	display_name = "Test gateway"
+	template = iotcentral_device_template.test.id
+}
+
+resource "iotcentral_deployment_manifest" "first" {
+	id = "testgatewaymanifest1"
+	display_name = "Test gateway manifest 1"
+	data = jsonencode({
+		"modulesContent" = {
+			"$edgeAgent" = {
+				"properties.desired" = {
+					"schemaVersion" = "1.1"
+					"modules" = {}
+				}
+			}
+		}
+	})
+}
+`
+
+// testAccDeviceDeploymentManifestAssignmentUpdateConfig assigns a second
+// deployment manifest with the given display name, applying it again
+// whenever it changes.
+const testAccDeviceDeploymentManifestAssignmentUpdateConfig = `
+resource "iotcentral_deployment_manifest" "second" {
+	id = "testgatewaymanifest2"
+	display_name = "%s"
+	data = iotcentral_deployment_manifest.first.data
+}
+
+resource "iotcentral_device_deployment_manifest_assignment" "test" {
+	device_id = iotcentral_device.test.id
+	deployment_manifest_id = iotcentral_deployment_manifest.second.id
+	deployment_manifest_etag = iotcentral_deployment_manifest.second.etag
+}
+`
+
+func TestAccIotCentralDeviceDeploymentManifestAssignmentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccDeviceDeploymentManifestAssignmentConfig + `
+				resource "iotcentral_device_deployment_manifest_assignment" "test" {
+					device_id = iotcentral_device.test.id
+					deployment_manifest_id = iotcentral_deployment_manifest.first.id
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify id is set
+					resource.TestCheckResourceAttr("iotcentral_device_deployment_manifest_assignment.test", "id", "testgateway"),
+					// Verify deployment_manifest_id is set
+					resource.TestCheckResourceAttr("iotcentral_device_deployment_manifest_assignment.test", "deployment_manifest_id", "testgatewaymanifest1"),
+					// Verify deployment_manifest_etag is set
+					resource.TestCheckResourceAttrPair("iotcentral_device_deployment_manifest_assignment.test", "deployment_manifest_etag", "iotcentral_deployment_manifest.first", "etag"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "iotcentral_device_deployment_manifest_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccDeviceDeploymentManifestAssignmentConfig +
+					fmt.Sprintf(testAccDeviceDeploymentManifestAssignmentUpdateConfig, "Test gateway manifest 2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify deployment_manifest_id is updated
+					resource.TestCheckResourceAttr("iotcentral_device_deployment_manifest_assignment.test", "deployment_manifest_id", "testgatewaymanifest2"),
+					// Verify deployment_manifest_etag is updated
+					resource.TestCheckResourceAttrPair("iotcentral_device_deployment_manifest_assignment.test", "deployment_manifest_etag", "iotcentral_deployment_manifest.second", "etag"),
+				),
+			},
+			// Changing the manifest applies it again in the same apply
+			{
+				Config: providerConfig + testAccDeviceDeploymentManifestAssignmentConfig +
+					fmt.Sprintf(testAccDeviceDeploymentManifestAssignmentUpdateConfig, "Test gateway manifest 2 updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the changed manifest is applied
+					resource.TestCheckResourceAttr("iotcentral_device_deployment_manifest_assignment.test", "deployment_manifest_id", "testgatewaymanifest2"),
+					resource.TestCheckResourceAttrPair("iotcentral_device_deployment_manifest_assignment.test", "deployment_manifest_etag", "iotcentral_deployment_manifest.second", "etag"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
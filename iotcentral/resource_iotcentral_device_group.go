@@ -0,0 +1,361 @@
+package iotcentral
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &deviceGroupResource{}
+	_ resource.ResourceWithConfigure   = &deviceGroupResource{}
+	_ resource.ResourceWithImportState = &deviceGroupResource{}
+)
+
+// NewDeviceGroupResource is a helper function to simplify the provider implementation.
+func NewDeviceGroupResource() resource.Resource {
+	return &deviceGroupResource{}
+}
+
+// deviceGroupResource is the resource implementation.
+type deviceGroupResource struct {
+	client *iotcentral.Client
+}
+
+// deviceGroupResourceModel maps device group schema data.
+type deviceGroupResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	DisplayName   types.String   `tfsdk:"display_name"`
+	Description   types.String   `tfsdk:"description"`
+	Filter        types.String   `tfsdk:"filter"`
+	Organizations types.List     `tfsdk:"organizations"`
+	Etag          types.String   `tfsdk:"etag"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *deviceGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *deviceGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a device group. Device groups select devices with a filter and are used by dashboards, jobs and data exports.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique ID of the device group.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Description: "Display name of the device group.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Short summary of the device group.",
+				Optional:    true,
+			},
+			"filter": schema.StringAttribute{
+				Description: "Query that selects the devices of the device group, such as " +
+					"`SELECT * FROM devices WHERE $template = \"dtmi:example:thermostat;1\"`.",
+				Required: true,
+				Validators: []validator.String{
+					queryValidator{from: "devices"},
+				},
+			},
+			"organizations": schema.ListAttribute{
+				Description: "List of IDs of the organizations the device group is available to.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"etag": schema.StringAttribute{
+				Description: "ETag of the device group, changed on every update.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *deviceGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*iotcentral.Client)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *deviceGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan deviceGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var deviceGroupID = plan.ID.ValueString()
+	deviceGroupRequest, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create new device group
+	deviceGroup, err := createDeviceGroup(ctx, r.client, deviceGroupID, deviceGroupRequest)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_device_group "+deviceGroupID, "create", createTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error creating device group",
+			"Could not create device group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	diags = plan.fromAPI(ctx, deviceGroup)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *deviceGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state deviceGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed device group value from IotCentral
+	deviceGroup, err := getDeviceGroup(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_device_group "+state.ID.ValueString(), "read", readTimeout) {
+			return
+		}
+
+		// Remove the resource from state when it was deleted outside Terraform
+		if isNotFound(err) {
+			tflog.Warn(ctx, "IotCentral device group not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading IotCentral Device Group",
+			"Could not read IotCentral device group ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	diags = state.fromAPI(ctx, deviceGroup)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *deviceGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan deviceGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var deviceGroupID = plan.ID.ValueString()
+	deviceGroupRequest, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing device group
+	deviceGroup, err := updateDeviceGroup(ctx, r.client, deviceGroupID, deviceGroupRequest)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_device_group "+deviceGroupID, "update", updateTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Updating IotCentral Device Group",
+			"Could not update device group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated items
+	diags = plan.fromAPI(ctx, deviceGroup)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *deviceGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state deviceGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing device group
+	err := deleteDeviceGroup(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_device_group "+state.ID.ValueString(), "delete", deleteTimeout) {
+			return
+		}
+
+		// Nothing to delete when the resource is already gone
+		if isNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting IotCentral Device Group",
+			"Could not delete device group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *deviceGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI generates the API request body of the device group.
+func (m *deviceGroupResourceModel) toAPI(ctx context.Context) (deviceGroup, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	request := deviceGroup{
+		DisplayName:   m.DisplayName.ValueString(),
+		Description:   m.Description.ValueString(),
+		Filter:        m.Filter.ValueString(),
+		Organizations: []string{},
+	}
+
+	if !m.Organizations.IsNull() && !m.Organizations.IsUnknown() {
+		diags.Append(m.Organizations.ElementsAs(ctx, &request.Organizations, false)...)
+	}
+
+	return request, diags
+}
+
+// fromAPI maps a device group received from the API to the model.
+func (m *deviceGroupResourceModel) fromAPI(ctx context.Context, group *deviceGroup) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(group.ID)
+	m.DisplayName = types.StringValue(group.DisplayName)
+	m.Filter = types.StringValue(group.Filter)
+	m.Etag = types.StringValue(group.Etag)
+
+	if group.Description != "" {
+		m.Description = types.StringValue(group.Description)
+	} else {
+		m.Description = types.StringNull()
+	}
+
+	// Keep an unset list unset when the device group belongs to no organization
+	if len(group.Organizations) > 0 || (!m.Organizations.IsNull() && !m.Organizations.IsUnknown()) {
+		organizationIDs := group.Organizations
+		if organizationIDs == nil {
+			organizationIDs = []string{}
+		}
+
+		organizations, d := types.ListValueFrom(ctx, types.StringType, organizationIDs)
+		diags.Append(d...)
+		m.Organizations = organizations
+	}
+
+	return diags
+}
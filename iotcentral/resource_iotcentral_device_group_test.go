@@ -0,0 +1,73 @@
+package iotcentral
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIotCentralDeviceGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Filter validation testing
+			{
+				Config: providerConfig + `
+				resource "iotcentral_device_group" "test" {
+					id = "testdevicegroup"
+					display_name = "Test device group"
+					filter = "SELECT * FROM devices WHERE $simulated = \"true"
+				}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Query`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "iotcentral_device_group" "test" {
+					id = "testdevicegroup"
+					display_name = "Test device group"
+					filter = "SELECT * FROM devices WHERE $simulated = true"
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify id is set
+					resource.TestCheckResourceAttr("iotcentral_device_group.test", "id", "testdevicegroup"),
+					// Verify display_name is set
+					resource.TestCheckResourceAttr("iotcentral_device_group.test", "display_name", "Test device group"),
+					// Verify filter is set
+					resource.TestCheckResourceAttr("iotcentral_device_group.test", "filter", "SELECT * FROM devices WHERE $simulated = true"),
+					// Verify etag is set
+					resource.TestCheckResourceAttrSet("iotcentral_device_group.test", "etag"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "iotcentral_device_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "iotcentral_device_group" "test" {
+					id = "testdevicegroup"
+					display_name = "Test device group updated"
+					description = "Simulated devices"
+					filter = "SELECT * FROM devices WHERE $simulated = true"
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify id is same
+					resource.TestCheckResourceAttr("iotcentral_device_group.test", "id", "testdevicegroup"),
+					// Verify display_name is updated
+					resource.TestCheckResourceAttr("iotcentral_device_group.test", "display_name", "Test device group updated"),
+					// Verify description is updated
+					resource.TestCheckResourceAttr("iotcentral_device_group.test", "description", "Simulated devices"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,374 @@
+package iotcentral
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &deviceTemplateResource{}
+	_ resource.ResourceWithConfigure   = &deviceTemplateResource{}
+	_ resource.ResourceWithImportState = &deviceTemplateResource{}
+)
+
+// defaultDeviceTemplateTypes are the @type values of a device template when
+// none are configured.
+var defaultDeviceTemplateTypes = []string{"ModelDefinition", "DeviceModel"}
+
+// defaultDeviceTemplateTypesValue returns the default @type values as a list value.
+func defaultDeviceTemplateTypesValue() types.List {
+	var elements []attr.Value
+	for _, t := range defaultDeviceTemplateTypes {
+		elements = append(elements, types.StringValue(t))
+	}
+
+	return types.ListValueMust(types.StringType, elements)
+}
+
+// NewDeviceTemplateResource is a helper function to simplify the provider implementation.
+func NewDeviceTemplateResource() resource.Resource {
+	return &deviceTemplateResource{}
+}
+
+// deviceTemplateResource is the resource implementation.
+type deviceTemplateResource struct {
+	client *iotcentral.Client
+}
+
+// deviceTemplateResourceModel maps device template schema data.
+type deviceTemplateResourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	DisplayName     types.String   `tfsdk:"display_name"`
+	Description     types.String   `tfsdk:"description"`
+	Types           types.List     `tfsdk:"types"`
+	CapabilityModel types.String   `tfsdk:"capability_model"`
+	Etag            types.String   `tfsdk:"etag"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *deviceTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_template"
+}
+
+// Schema defines the schema for the resource.
+func (r *deviceTemplateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a device template. Templates are published when they are created or updated.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique DTMI of the device template, such as `dtmi:contoso:thermostat;1`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Description: "Display name of the device template.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Detailed description of the device template.",
+				Optional:    true,
+			},
+			"types": schema.ListAttribute{
+				Description: "The JSON-LD `@type` values of the device template. Defaults to `[\"ModelDefinition\", \"DeviceModel\"]`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     listdefault.StaticValue(defaultDeviceTemplateTypesValue()),
+			},
+			"capability_model": schema.StringAttribute{
+				Description: "The DTDL capability model of the device template as a JSON document, for example created with `jsonencode` or `file`. " +
+					"Semantically equal JSON does not cause a change.",
+				Required: true,
+				Validators: []validator.String{
+					jsonValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					suppressEquivalentJSON(),
+				},
+			},
+			"etag": schema.StringAttribute{
+				Description: "ETag of the device template, changed on every update.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *deviceTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*iotcentral.Client)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *deviceTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan deviceTemplateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var templateID = plan.ID.ValueString()
+	template, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create and publish new device template
+	deviceTemplate, err := putDeviceTemplate(ctx, r.client, templateID, template)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_device_template "+templateID, "create", createTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error creating device template",
+			"Could not create device template, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values,
+	// keeping the planned capability model as Terraform requires.
+	diags = plan.fromAPI(ctx, deviceTemplate, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *deviceTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state deviceTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed device template value from IotCentral
+	deviceTemplate, err := getDeviceTemplate(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_device_template "+state.ID.ValueString(), "read", readTimeout) {
+			return
+		}
+
+		// Remove the resource from state when it was deleted outside Terraform
+		if isNotFound(err) {
+			tflog.Warn(ctx, "IotCentral device template not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading IotCentral Device Template",
+			"Could not read IotCentral device template ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	diags = state.fromAPI(ctx, deviceTemplate, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *deviceTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan deviceTemplateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var templateID = plan.ID.ValueString()
+	template, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Publish the updated device template
+	deviceTemplate, err := putDeviceTemplate(ctx, r.client, templateID, template)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_device_template "+templateID, "update", updateTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Updating IotCentral Device Template",
+			"Could not update device template, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated items
+	diags = plan.fromAPI(ctx, deviceTemplate, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *deviceTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state deviceTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing device template
+	err := deleteDeviceTemplate(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_device_template "+state.ID.ValueString(), "delete", deleteTimeout) {
+			return
+		}
+
+		// Nothing to delete when the resource is already gone
+		if isNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting IotCentral Device Template",
+			"Could not delete device template, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *deviceTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI generates the API request body of the device template.
+func (m *deviceTemplateResourceModel) toAPI(ctx context.Context) (deviceTemplate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	template := deviceTemplate{
+		ID:              m.ID.ValueString(),
+		Types:           defaultDeviceTemplateTypes,
+		DisplayName:     m.DisplayName.ValueString(),
+		Description:     m.Description.ValueString(),
+		CapabilityModel: json.RawMessage(m.CapabilityModel.ValueString()),
+	}
+
+	if !m.Types.IsNull() && !m.Types.IsUnknown() {
+		diags.Append(m.Types.ElementsAs(ctx, &template.Types, false)...)
+	}
+
+	return template, diags
+}
+
+// fromAPI maps a device template received from the API to the model. The
+// received capability model only replaces a semantically different value
+// when refresh is set, as applied state must match the plan.
+func (m *deviceTemplateResourceModel) fromAPI(ctx context.Context, template *deviceTemplate, refresh bool) diag.Diagnostics {
+	m.ID = types.StringValue(template.ID)
+	m.DisplayName = types.StringValue(template.DisplayName)
+	m.Description = optionalStringValue(template.Description)
+	m.Etag = types.StringValue(template.Etag)
+
+	if refresh {
+		m.CapabilityModel = jsonStateValue(m.CapabilityModel, template.CapabilityModel)
+	}
+
+	templateTypes, diags := types.ListValueFrom(ctx, types.StringType, template.Types)
+	m.Types = templateTypes
+
+	return diags
+}
@@ -0,0 +1,85 @@
+package iotcentral
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIotCentralDeviceTemplateResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "iotcentral_device_template" "test" {
+					id = "dtmi:terraform:test:thermostat;1"
+					display_name = "Thermostat"
+					capability_model = jsonencode({
+						"@id" = "dtmi:terraform:test:thermostat:model;1"
+						"@type" = "Interface"
+						"displayName" = "Thermostat"
+						"contents" = [
+							{
+								"@type" = "Telemetry"
+								"name" = "temperature"
+								"schema" = "double"
+							}
+						]
+					})
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify id is set
+					resource.TestCheckResourceAttr("iotcentral_device_template.test", "id", "dtmi:terraform:test:thermostat;1"),
+					// Verify display_name is set
+					resource.TestCheckResourceAttr("iotcentral_device_template.test", "display_name", "Thermostat"),
+					// Verify default types are set
+					resource.TestCheckResourceAttr("iotcentral_device_template.test", "types.#", "2"),
+					resource.TestCheckResourceAttr("iotcentral_device_template.test", "types.0", "ModelDefinition"),
+					// Verify etag is set
+					resource.TestCheckResourceAttrSet("iotcentral_device_template.test", "etag"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "iotcentral_device_template.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"capability_model"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "iotcentral_device_template" "test" {
+					id = "dtmi:terraform:test:thermostat;1"
+					display_name = "Thermostat updated"
+					capability_model = jsonencode({
+						"@type" = "Interface"
+						"@id" = "dtmi:terraform:test:thermostat:model;1"
+						"displayName" = "Thermostat"
+						"contents" = [
+							{
+								"name" = "temperature"
+								"@type" = "Telemetry"
+								"schema" = "double"
+							},
+							{
+								"@type" = "Telemetry"
+								"name" = "humidity"
+								"schema" = "double"
+							}
+						]
+					})
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify display_name is updated
+					resource.TestCheckResourceAttr("iotcentral_device_template.test", "display_name", "Thermostat updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,95 @@
+package iotcentral
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIotCentralDeviceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "iotcentral_organization" "test" {
+					id = "testdeviceorg"
+					display_name = "Test device organization"
+				}
+
+				resource "iotcentral_device" "test" {
+					id = "testdevice1"
+					display_name = "Test device 1"
+					organizations = [iotcentral_organization.test.id]
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify id is set
+					resource.TestCheckResourceAttr("iotcentral_device.test", "id", "testdevice1"),
+					// Verify display_name is set
+					resource.TestCheckResourceAttr("iotcentral_device.test", "display_name", "Test device 1"),
+					// Verify defaults are set
+					resource.TestCheckResourceAttr("iotcentral_device.test", "simulated", "false"),
+					resource.TestCheckResourceAttr("iotcentral_device.test", "enabled", "true"),
+					// Verify organizations are set
+					resource.TestCheckResourceAttr("iotcentral_device.test", "organizations.#", "1"),
+					resource.TestCheckResourceAttr("iotcentral_device.test", "organizations.0", "testdeviceorg"),
+					// Verify computed attributes are set
+					resource.TestCheckResourceAttr("iotcentral_device.test", "provisioned", "false"),
+					resource.TestCheckResourceAttrSet("iotcentral_device.test", "etag"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "iotcentral_device.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "iotcentral_organization" "test" {
+					id = "testdeviceorg"
+					display_name = "Test device organization"
+				}
+
+				resource "iotcentral_device" "test" {
+					id = "testdevice1"
+					display_name = "Test device 1 updated"
+					enabled = false
+					organizations = [iotcentral_organization.test.id]
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify id is same
+					resource.TestCheckResourceAttr("iotcentral_device.test", "id", "testdevice1"),
+					// Verify display_name is updated
+					resource.TestCheckResourceAttr("iotcentral_device.test", "display_name", "Test device 1 updated"),
+					// Verify enabled is updated
+					resource.TestCheckResourceAttr("iotcentral_device.test", "enabled", "false"),
+				),
+			},
+			// Removed settings return to their defaults
+			{
+				Config: providerConfig + `
+				resource "iotcentral_organization" "test" {
+					id = "testdeviceorg"
+					display_name = "Test device organization"
+				}
+
+				resource "iotcentral_device" "test" {
+					id = "testdevice1"
+					display_name = "Test device 1 updated"
+					organizations = [iotcentral_organization.test.id]
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify enabled is back to its default
+					resource.TestCheckResourceAttr("iotcentral_device.test", "enabled", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
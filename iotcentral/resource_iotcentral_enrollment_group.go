@@ -0,0 +1,373 @@
+package iotcentral
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &enrollmentGroupResource{}
+	_ resource.ResourceWithConfigure   = &enrollmentGroupResource{}
+	_ resource.ResourceWithImportState = &enrollmentGroupResource{}
+)
+
+// NewEnrollmentGroupResource is a helper function to simplify the provider implementation.
+func NewEnrollmentGroupResource() resource.Resource {
+	return &enrollmentGroupResource{}
+}
+
+// enrollmentGroupResource is the resource implementation.
+type enrollmentGroupResource struct {
+	client *iotcentral.Client
+}
+
+// enrollmentGroupResourceModel maps enrollment group schema data.
+type enrollmentGroupResourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	DisplayName     types.String   `tfsdk:"display_name"`
+	Type            types.String   `tfsdk:"type"`
+	Enabled         types.Bool     `tfsdk:"enabled"`
+	AttestationType types.String   `tfsdk:"attestation_type"`
+	IDScope         types.String   `tfsdk:"id_scope"`
+	PrimaryKey      types.String   `tfsdk:"primary_key"`
+	SecondaryKey    types.String   `tfsdk:"secondary_key"`
+	Etag            types.String   `tfsdk:"etag"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *enrollmentGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_enrollment_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *enrollmentGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an enrollment group that devices use to provision themselves through the Device Provisioning Service. " +
+			"The X.509 certificates of an enrollment group are managed with `iotcentral_enrollment_group_certificate`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique ID of the enrollment group.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Description: "Display name of the enrollment group.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the devices that use the enrollment group, `iot` for IoT devices or `iotEdge` for IoT Edge devices. " +
+					"Defaults to `iot`. Changing the type creates a new enrollment group.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					oneOfValidator{values: []string{"iot", "iotEdge"}},
+				},
+				Default: stringdefault.StaticString("iot"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether devices are allowed to provision with the enrollment group. Defaults to `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"attestation_type": schema.StringAttribute{
+				Description: "Attestation mechanism of the enrollment group, `symmetricKey` for shared access signatures or `x509` " +
+					"for X.509 certificates. Changing the attestation type creates a new enrollment group.",
+				Required: true,
+				Validators: []validator.String{
+					oneOfValidator{values: []string{attestationTypeSymmetricKey, attestationTypeX509}},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id_scope": schema.StringAttribute{
+				Description: "ID scope of the Device Provisioning Service of the enrollment group.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"primary_key": schema.StringAttribute{
+				Description: "Primary key of the enrollment group, from which the keys of the devices are derived, when the attestation type is `symmetricKey`.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secondary_key": schema.StringAttribute{
+				Description: "Secondary key of the enrollment group, when the attestation type is `symmetricKey`.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"etag": schema.StringAttribute{
+				Description: "ETag of the enrollment group, changed on every update.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *enrollmentGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*iotcentral.Client)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *enrollmentGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan enrollmentGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var groupID = plan.ID.ValueString()
+	var groupRequest = plan.toAPI()
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create new enrollment group
+	group, err := createEnrollmentGroup(ctx, r.client, groupID, groupRequest)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_enrollment_group "+groupID, "create", createTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error creating enrollment group",
+			"Could not create enrollment group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.fromAPI(group)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *enrollmentGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state enrollmentGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed enrollment group value from IotCentral
+	group, err := getEnrollmentGroup(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_enrollment_group "+state.ID.ValueString(), "read", readTimeout) {
+			return
+		}
+
+		// Remove the resource from state when it was deleted outside Terraform
+		if isNotFound(err) {
+			tflog.Warn(ctx, "IotCentral enrollment group not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading IotCentral Enrollment Group",
+			"Could not read IotCentral enrollment group ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.fromAPI(group)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *enrollmentGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan enrollmentGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var groupID = plan.ID.ValueString()
+	var groupRequest = plan.toAPI()
+
+	// The type and attestation cannot change, changes create a new enrollment group
+	groupRequest.Type = ""
+	groupRequest.Attestation = nil
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing enrollment group
+	group, err := updateEnrollmentGroup(ctx, r.client, groupID, groupRequest)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_enrollment_group "+groupID, "update", updateTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Updating IotCentral Enrollment Group",
+			"Could not update enrollment group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated items
+	plan.fromAPI(group)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *enrollmentGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state enrollmentGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing enrollment group
+	err := deleteEnrollmentGroup(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_enrollment_group "+state.ID.ValueString(), "delete", deleteTimeout) {
+			return
+		}
+
+		// Nothing to delete when the resource is already gone
+		if isNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting IotCentral Enrollment Group",
+			"Could not delete enrollment group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *enrollmentGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI generates the API request body of the enrollment group.
+func (m *enrollmentGroupResourceModel) toAPI() enrollmentGroup {
+	request := enrollmentGroup{
+		DisplayName: m.DisplayName.ValueString(),
+		Enabled:     m.Enabled.ValueBool(),
+		Type:        m.Type.ValueString(),
+		Attestation: &enrollmentGroupAttestation{
+			Type: m.AttestationType.ValueString(),
+		},
+	}
+
+	return request
+}
+
+// fromAPI maps an enrollment group received from the API to the model.
+func (m *enrollmentGroupResourceModel) fromAPI(group *enrollmentGroup) {
+	m.ID = types.StringValue(group.ID)
+	m.DisplayName = types.StringValue(group.DisplayName)
+	m.Type = types.StringValue(group.Type)
+	m.Enabled = types.BoolValue(group.Enabled)
+	m.IDScope = types.StringValue(group.IDScope)
+	m.Etag = types.StringValue(group.Etag)
+	m.PrimaryKey = types.StringNull()
+	m.SecondaryKey = types.StringNull()
+
+	if group.Attestation != nil {
+		m.AttestationType = types.StringValue(group.Attestation.Type)
+
+		if group.Attestation.SymmetricKey != nil {
+			m.PrimaryKey = types.StringValue(group.Attestation.SymmetricKey.PrimaryKey)
+			m.SecondaryKey = types.StringValue(group.Attestation.SymmetricKey.SecondaryKey)
+		}
+	}
+}
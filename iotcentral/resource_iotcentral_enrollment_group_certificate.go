@@ -0,0 +1,531 @@
+package iotcentral
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &enrollmentGroupCertificateResource{}
+	_ resource.ResourceWithConfigure   = &enrollmentGroupCertificateResource{}
+	_ resource.ResourceWithImportState = &enrollmentGroupCertificateResource{}
+)
+
+// NewEnrollmentGroupCertificateResource is a helper function to simplify the provider implementation.
+func NewEnrollmentGroupCertificateResource() resource.Resource {
+	return &enrollmentGroupCertificateResource{}
+}
+
+// enrollmentGroupCertificateResource is the resource implementation.
+type enrollmentGroupCertificateResource struct {
+	client *iotcentral.Client
+}
+
+// enrollmentGroupCertificateResourceModel maps enrollment group certificate schema data.
+type enrollmentGroupCertificateResourceModel struct {
+	ID                      types.String   `tfsdk:"id"`
+	EnrollmentGroupID       types.String   `tfsdk:"enrollment_group_id"`
+	Entry                   types.String   `tfsdk:"entry"`
+	Certificate             types.String   `tfsdk:"certificate"`
+	SkipVerification        types.Bool     `tfsdk:"skip_verification"`
+	PrivateKey              types.String   `tfsdk:"private_key"`
+	VerificationCertificate types.String   `tfsdk:"verification_certificate"`
+	VerificationCode        types.String   `tfsdk:"verification_code"`
+	Verified                types.Bool     `tfsdk:"verified"`
+	Thumbprint              types.String   `tfsdk:"thumbprint"`
+	Etag                    types.String   `tfsdk:"etag"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *enrollmentGroupCertificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_enrollment_group_certificate"
+}
+
+// Schema defines the schema for the resource.
+func (r *enrollmentGroupCertificateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the primary or secondary X.509 CA certificate of an enrollment group with the `x509` attestation type. " +
+			"The certificate is verified with a certificate signed by the CA for the `verification_code`, which the provider " +
+			"creates when the `private_key` of the CA is set. Otherwise set the `verification_certificate` in a later apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the certificate in the format `<enrollment_group_id>/<entry>`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enrollment_group_id": schema.StringAttribute{
+				Description: "ID of the enrollment group.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entry": schema.StringAttribute{
+				Description: "Whether this is the `primary` or `secondary` certificate of the enrollment group.",
+				Required:    true,
+				Validators: []validator.String{
+					oneOfValidator{values: []string{"primary", "secondary"}},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate": schema.StringAttribute{
+				Description: "The X.509 CA certificate, PEM or base64 encoded. Changing the certificate replaces it.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"skip_verification": schema.BoolAttribute{
+				Description: "Mark the certificate as verified without proof of possession of its private key.",
+				Optional:    true,
+			},
+			"private_key": schema.StringAttribute{
+				Description: "PEM encoded private key of the CA, used to sign a verification certificate for the `verification_code`.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"verification_certificate": schema.StringAttribute{
+				Description: "Certificate signed by the CA with the `verification_code` as its subject common name, " +
+					"PEM or base64 encoded. Setting it verifies the certificate.",
+				Optional: true,
+			},
+			"verification_code": schema.StringAttribute{
+				Description: "Code to use as the subject common name of the `verification_certificate`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"verified": schema.BoolAttribute{
+				Description: "Whether the certificate has been verified.",
+				Computed:    true,
+			},
+			"thumbprint": schema.StringAttribute{
+				Description: "SHA1 thumbprint of the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"etag": schema.StringAttribute{
+				Description: "ETag of the certificate, changed on every update.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *enrollmentGroupCertificateResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*iotcentral.Client)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *enrollmentGroupCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan enrollmentGroupCertificateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var groupID = plan.EnrollmentGroupID.ValueString()
+	var entry = plan.Entry.ValueString()
+	var certificateRequest = enrollmentGroupCertificate{
+		Certificate: certificateBase64(plan.Certificate.ValueString()),
+		Verified:    plan.SkipVerification.ValueBool(),
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Set the certificate of the enrollment group
+	certificate, err := putEnrollmentGroupCertificate(ctx, r.client, groupID, entry, certificateRequest)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_enrollment_group_certificate "+groupID+"/"+entry, "create", createTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error creating enrollment group certificate",
+			"Could not create enrollment group certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Verify the certificate or generate the code to verify it with
+	certificate, err = r.verify(ctx, &plan, certificate)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_enrollment_group_certificate "+groupID+"/"+entry, "create", createTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error verifying enrollment group certificate",
+			"Could not verify enrollment group certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(groupID + "/" + entry)
+	plan.fromAPI(certificate)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *enrollmentGroupCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state enrollmentGroupCertificateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed certificate value from IotCentral
+	certificate, err := getEnrollmentGroupCertificate(ctx, r.client, state.EnrollmentGroupID.ValueString(), state.Entry.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_enrollment_group_certificate "+state.ID.ValueString(), "read", readTimeout) {
+			return
+		}
+
+		// Remove the resource from state when it was deleted outside Terraform
+		if isNotFound(err) {
+			tflog.Warn(ctx, "IotCentral enrollment group certificate not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading IotCentral Enrollment Group Certificate",
+			"Could not read IotCentral enrollment group certificate ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.fromAPI(certificate)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// The certificate itself cannot change in place, so updates verify it.
+func (r *enrollmentGroupCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan enrollmentGroupCertificateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var groupID = plan.EnrollmentGroupID.ValueString()
+	var entry = plan.Entry.ValueString()
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Get the current certificate of the enrollment group
+	certificate, err := getEnrollmentGroupCertificate(ctx, r.client, groupID, entry)
+	if err == nil && !certificate.Verified && plan.SkipVerification.ValueBool() {
+		// Mark the certificate as verified without proof of possession
+		certificate, err = putEnrollmentGroupCertificate(ctx, r.client, groupID, entry, enrollmentGroupCertificate{
+			Certificate: certificateBase64(plan.Certificate.ValueString()),
+			Verified:    true,
+			Etag:        certificate.Etag,
+		})
+	}
+
+	if err == nil {
+		certificate, err = r.verify(ctx, &plan, certificate)
+	}
+
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_enrollment_group_certificate "+plan.ID.ValueString(), "update", updateTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Updating IotCentral Enrollment Group Certificate",
+			"Could not update enrollment group certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated items
+	plan.fromAPI(certificate)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *enrollmentGroupCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state enrollmentGroupCertificateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Remove the certificate from the enrollment group
+	err := deleteEnrollmentGroupCertificate(ctx, r.client, state.EnrollmentGroupID.ValueString(), state.Entry.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_enrollment_group_certificate "+state.ID.ValueString(), "delete", deleteTimeout) {
+			return
+		}
+
+		// Nothing to delete when the resource is already gone
+		if isNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting IotCentral Enrollment Group Certificate",
+			"Could not delete enrollment group certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *enrollmentGroupCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Split the import ID into the enrollment group ID and the entry
+	groupID, entry, found := strings.Cut(req.ID, "/")
+	if !found || groupID == "" || (entry != "primary" && entry != "secondary") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected an import identifier in the format <enrollment_group_id>/<primary|secondary>, got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("enrollment_group_id"), groupID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("entry"), entry)...)
+}
+
+// verify generates a verification code for an unverified certificate when it
+// has none yet, and verifies the certificate with the configured or a signed
+// verification certificate. It returns the refreshed certificate.
+func (r *enrollmentGroupCertificateResource) verify(ctx context.Context, m *enrollmentGroupCertificateResourceModel, certificate *enrollmentGroupCertificate) (*enrollmentGroupCertificate, error) {
+	groupID := m.EnrollmentGroupID.ValueString()
+	entry := m.Entry.ValueString()
+
+	if certificate.Verified {
+		if m.VerificationCode.IsUnknown() {
+			m.VerificationCode = types.StringNull()
+		}
+
+		return certificate, nil
+	}
+
+	if m.VerificationCode.IsNull() || m.VerificationCode.IsUnknown() {
+		code, err := generateEnrollmentGroupVerificationCode(ctx, r.client, groupID, entry)
+		if err != nil {
+			return nil, err
+		}
+
+		m.VerificationCode = types.StringValue(code)
+	}
+
+	// Prove possession of the private key with a certificate for the verification code
+	verificationCertificate := m.VerificationCertificate.ValueString()
+	if !m.PrivateKey.IsNull() {
+		var err error
+		verificationCertificate, err = signVerificationCertificate(m.Certificate.ValueString(), m.PrivateKey.ValueString(), m.VerificationCode.ValueString())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if verificationCertificate != "" {
+		err := verifyEnrollmentGroupCertificate(ctx, r.client, groupID, entry, certificateBase64(verificationCertificate))
+		if err != nil {
+			return nil, err
+		}
+
+		return getEnrollmentGroupCertificate(ctx, r.client, groupID, entry)
+	}
+
+	return certificate, nil
+}
+
+// fromAPI maps a certificate received from the API to the model. The
+// configured certificate is kept when it encodes the same certificate.
+func (m *enrollmentGroupCertificateResourceModel) fromAPI(certificate *enrollmentGroupCertificate) {
+	m.Verified = types.BoolValue(certificate.Verified)
+	m.Etag = types.StringValue(certificate.Etag)
+	m.Thumbprint = types.StringNull()
+
+	if certificate.Info != nil {
+		m.Thumbprint = types.StringValue(certificate.Info.SHA1Thumbprint)
+	}
+
+	if certificate.Certificate != "" && (m.Certificate.IsNull() || certificateBase64(m.Certificate.ValueString()) != certificateBase64(certificate.Certificate)) {
+		m.Certificate = types.StringValue(certificate.Certificate)
+	}
+
+	if m.VerificationCode.IsUnknown() {
+		m.VerificationCode = types.StringNull()
+	}
+}
+
+// certificateBase64 returns the base64 encoded DER of a PEM or base64
+// encoded certificate, as expected by the API.
+func certificateBase64(certificate string) string {
+	if block, _ := pem.Decode([]byte(certificate)); block != nil {
+		return base64.StdEncoding.EncodeToString(block.Bytes)
+	}
+
+	return strings.Join(strings.Fields(certificate), "")
+}
+
+// signVerificationCertificate creates a certificate for the verification code
+// signed by the CA, which proves possession of its private key.
+func signVerificationCertificate(caCertificate, caPrivateKey, verificationCode string) (string, error) {
+	certBlock, _ := pem.Decode([]byte(caCertificate))
+	if certBlock == nil {
+		return "", fmt.Errorf("certificate is not PEM encoded, set verification_certificate instead of private_key")
+	}
+
+	ca, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return "", fmt.Errorf("parsing certificate: %w", err)
+	}
+
+	keyBlock, _ := pem.Decode([]byte(caPrivateKey))
+	if keyBlock == nil {
+		return "", fmt.Errorf("private_key is not PEM encoded")
+	}
+
+	var signer crypto.Signer
+	switch keyBlock.Type {
+	case "RSA PRIVATE KEY":
+		signer, err = x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
+	case "EC PRIVATE KEY":
+		signer, err = x509.ParseECPrivateKey(keyBlock.Bytes)
+	default:
+		var key any
+		key, err = x509.ParsePKCS8PrivateKey(keyBlock.Bytes)
+		if err == nil {
+			var ok bool
+			if signer, ok = key.(crypto.Signer); !ok {
+				err = fmt.Errorf("unsupported private key type %T", key)
+			}
+		}
+	}
+	if err != nil {
+		return "", fmt.Errorf("parsing private_key: %w", err)
+	}
+
+	// The verification certificate only needs a key of its own to be signed
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return "", err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: verificationCode},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, key.Public(), signer)
+	if err != nil {
+		return "", fmt.Errorf("signing verification certificate: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(der), nil
+}
@@ -0,0 +1,210 @@
+package iotcentral
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccEnrollmentGroupCertificateConfig creates an X.509 enrollment group
+// with a self-signed CA certificate.
+const testAccEnrollmentGroupCertificateConfig = `
+resource "tls_private_key" "test" {
+	algorithm = "RSA"
+}
+
+resource "tls_self_signed_cert" "test" {
+	private_key_pem = tls_private_key.test.private_key_pem
+	validity_period_hours = 24
+	is_ca_certificate = true
+	allowed_uses = ["cert_signing"]
+
+	subject {
+		common_name = "Terraform Test CA"
+	}
+}
+
+resource "iotcentral_enrollment_group" "test" {
+	id = "testx509enrollmentgroup"
+	display_name = "Test X.509 enrollment group"
+	attestation_type = "x509"
+}
+`
+
+func TestAccIotCentralEnrollmentGroupCertificateResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"tls": {Source: "hashicorp/tls"},
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccEnrollmentGroupCertificateConfig + `
+				resource "iotcentral_enrollment_group_certificate" "test" {
+					enrollment_group_id = iotcentral_enrollment_group.test.id
+					entry = "primary"
+					certificate = tls_self_signed_cert.test.cert_pem
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify id is set
+					resource.TestCheckResourceAttr("iotcentral_enrollment_group_certificate.test", "id", "testx509enrollmentgroup/primary"),
+					// Verify the certificate awaits verification
+					resource.TestCheckResourceAttr("iotcentral_enrollment_group_certificate.test", "verified", "false"),
+					resource.TestCheckResourceAttrSet("iotcentral_enrollment_group_certificate.test", "verification_code"),
+					resource.TestCheckResourceAttrSet("iotcentral_enrollment_group_certificate.test", "thumbprint"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "iotcentral_enrollment_group_certificate.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"certificate", "verification_code"},
+			},
+			// Verification testing
+			{
+				Config: providerConfig + testAccEnrollmentGroupCertificateConfig + `
+				resource "iotcentral_enrollment_group_certificate" "test" {
+					enrollment_group_id = iotcentral_enrollment_group.test.id
+					entry = "primary"
+					certificate = tls_self_signed_cert.test.cert_pem
+					private_key = tls_private_key.test.private_key_pem
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the certificate is verified
+					resource.TestCheckResourceAttr("iotcentral_enrollment_group_certificate.test", "verified", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestSignVerificationCertificate(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ecDER, err := x509.MarshalECPrivateKey(ecKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	edDER, err := x509.MarshalPKCS8PrivateKey(edKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rsaPEM := testPEM("RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey))
+	ecPEM := testPEM("EC PRIVATE KEY", ecDER)
+	edPEM := testPEM("PRIVATE KEY", edDER)
+
+	tests := []struct {
+		name    string
+		key     crypto.Signer
+		keyPEM  string
+		wantErr bool
+	}{
+		{name: "rsa", key: rsaKey, keyPEM: rsaPEM},
+		{name: "ec", key: ecKey, keyPEM: ecPEM},
+		{name: "pkcs8", key: edKey, keyPEM: edPEM},
+		{name: "other key", key: rsaKey, keyPEM: ecPEM, wantErr: true},
+		{name: "not pem", key: rsaKey, keyPEM: "not a key", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ca := testCACertificate(t, tt.key)
+			caPEM := testPEM("CERTIFICATE", ca.Raw)
+
+			signed, err := signVerificationCertificate(caPEM, tt.keyPEM, "code123")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("signVerificationCertificate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			der, err := base64.StdEncoding.DecodeString(signed)
+			if err != nil {
+				t.Fatalf("verification certificate is not base64 encoded: %v", err)
+			}
+
+			certificate, err := x509.ParseCertificate(der)
+			if err != nil {
+				t.Fatalf("parsing verification certificate: %v", err)
+			}
+
+			if certificate.Subject.CommonName != "code123" {
+				t.Errorf("common name = %q, want %q", certificate.Subject.CommonName, "code123")
+			}
+
+			if err := certificate.CheckSignatureFrom(ca); err != nil {
+				t.Errorf("verification certificate is not signed by the CA: %v", err)
+			}
+		})
+	}
+
+	if _, err := signVerificationCertificate("not a certificate", rsaPEM, "code123"); err == nil {
+		t.Error("signVerificationCertificate() expected an error for a certificate that is not PEM encoded")
+	}
+}
+
+// testCACertificate creates a self-signed CA certificate for the key.
+func testCACertificate(t *testing.T, key crypto.Signer) *x509.Certificate {
+	t.Helper()
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return certificate
+}
+
+// testPEM encodes DER data as a PEM block.
+func testPEM(blockType string, der []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}))
+}
@@ -0,0 +1,78 @@
+package iotcentral
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIotCentralEnrollmentGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "iotcentral_enrollment_group" "test" {
+					id = "testenrollmentgroup"
+					display_name = "Test enrollment group"
+					attestation_type = "symmetricKey"
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify id is set
+					resource.TestCheckResourceAttr("iotcentral_enrollment_group.test", "id", "testenrollmentgroup"),
+					// Verify display_name is set
+					resource.TestCheckResourceAttr("iotcentral_enrollment_group.test", "display_name", "Test enrollment group"),
+					// Verify defaults are set
+					resource.TestCheckResourceAttr("iotcentral_enrollment_group.test", "type", "iot"),
+					resource.TestCheckResourceAttr("iotcentral_enrollment_group.test", "enabled", "true"),
+					// Verify computed attributes are set
+					resource.TestCheckResourceAttrSet("iotcentral_enrollment_group.test", "id_scope"),
+					resource.TestCheckResourceAttrSet("iotcentral_enrollment_group.test", "primary_key"),
+					resource.TestCheckResourceAttrSet("iotcentral_enrollment_group.test", "secondary_key"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "iotcentral_enrollment_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "iotcentral_enrollment_group" "test" {
+					id = "testenrollmentgroup"
+					display_name = "Test enrollment group updated"
+					attestation_type = "symmetricKey"
+					enabled = false
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify id is same
+					resource.TestCheckResourceAttr("iotcentral_enrollment_group.test", "id", "testenrollmentgroup"),
+					// Verify display_name is updated
+					resource.TestCheckResourceAttr("iotcentral_enrollment_group.test", "display_name", "Test enrollment group updated"),
+					// Verify enabled is updated
+					resource.TestCheckResourceAttr("iotcentral_enrollment_group.test", "enabled", "false"),
+				),
+			},
+			// Removed settings return to their defaults
+			{
+				Config: providerConfig + `
+				resource "iotcentral_enrollment_group" "test" {
+					id = "testenrollmentgroup"
+					display_name = "Test enrollment group updated"
+					attestation_type = "symmetricKey"
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify enabled is back to its default
+					resource.TestCheckResourceAttr("iotcentral_enrollment_group.test", "enabled", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,336 @@
+package iotcentral
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// fileUploadID is the ID of the file upload configuration, of which every
+// application has at most one.
+const fileUploadID = "fileUpload"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &fileUploadResource{}
+	_ resource.ResourceWithConfigure   = &fileUploadResource{}
+	_ resource.ResourceWithImportState = &fileUploadResource{}
+)
+
+// NewFileUploadResource is a helper function to simplify the provider implementation.
+func NewFileUploadResource() resource.Resource {
+	return &fileUploadResource{}
+}
+
+// fileUploadResource is the resource implementation.
+type fileUploadResource struct {
+	client *iotcentral.Client
+}
+
+// fileUploadResourceModel maps file upload schema data.
+type fileUploadResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	ConnectionString types.String   `tfsdk:"connection_string"`
+	Container        types.String   `tfsdk:"container"`
+	Account          types.String   `tfsdk:"account"`
+	SasTTL           types.String   `tfsdk:"sas_ttl"`
+	State            types.String   `tfsdk:"state"`
+	Etag             types.String   `tfsdk:"etag"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *fileUploadResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_upload"
+}
+
+// Schema defines the schema for the resource.
+func (r *fileUploadResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the storage account that devices upload files to. An application has a single file upload configuration, " +
+			"so only one instance of this resource should exist per application.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the file upload configuration, always `" + fileUploadID + "`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_string": schema.StringAttribute{
+				Description: "Connection string of the storage account that devices upload files to.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"container": schema.StringAttribute{
+				Description: "Name of the storage container that devices upload files to.",
+				Required:    true,
+			},
+			"account": schema.StringAttribute{
+				Description: "Name of the storage account that devices upload files to.",
+				Optional:    true,
+			},
+			"sas_ttl": schema.StringAttribute{
+				Description: "ISO 8601 duration for which the shared access signature of an upload is valid, such as `PT1H`. Defaults to `PT1H`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("PT1H"),
+			},
+			"state": schema.StringAttribute{
+				Description: "Provisioning state of the file upload configuration.",
+				Computed:    true,
+			},
+			"etag": schema.StringAttribute{
+				Description: "ETag of the file upload configuration, changed on every update.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *fileUploadResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*iotcentral.Client)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *fileUploadResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan fileUploadResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var fileUploadRequest = plan.toAPI()
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create file upload configuration and wait until it is provisioned
+	upload, err := putFileUpload(ctx, r.client, fileUploadRequest)
+	if err == nil {
+		upload, err = waitForFileUpload(ctx, r.client)
+	}
+
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_file_upload", "create", createTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error creating file upload",
+			"Could not create file upload configuration, unexpected error: "+redactSecrets(err.Error(), fileUploadRequest.ConnectionString),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.fromAPI(upload)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *fileUploadResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state fileUploadResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed file upload configuration from IotCentral
+	upload, err := getFileUpload(ctx, r.client)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_file_upload", "read", readTimeout) {
+			return
+		}
+
+		// Remove the resource from state when it was deleted outside Terraform
+		if isNotFound(err) {
+			tflog.Warn(ctx, "IotCentral file upload configuration not found, removing from state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading IotCentral File Upload",
+			"Could not read IotCentral file upload configuration: "+redactSecrets(err.Error(), state.ConnectionString.ValueString()),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.fromAPI(upload)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *fileUploadResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan fileUploadResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var fileUploadRequest = plan.toAPI()
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update file upload configuration and wait until it is provisioned
+	upload, err := putFileUpload(ctx, r.client, fileUploadRequest)
+	if err == nil {
+		upload, err = waitForFileUpload(ctx, r.client)
+	}
+
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_file_upload", "update", updateTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Updating IotCentral File Upload",
+			"Could not update file upload configuration, unexpected error: "+redactSecrets(err.Error(), fileUploadRequest.ConnectionString),
+		)
+		return
+	}
+
+	// Update resource state with updated items
+	plan.fromAPI(upload)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *fileUploadResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state fileUploadResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete file upload configuration and wait until it is removed
+	err := deleteFileUpload(ctx, r.client)
+	if err == nil {
+		err = waitForFileUploadDeleted(ctx, r.client)
+	}
+
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_file_upload", "delete", deleteTimeout) {
+			return
+		}
+
+		// Nothing to delete when the resource is already gone
+		if isNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting IotCentral File Upload",
+			"Could not delete file upload configuration, unexpected error: "+redactSecrets(err.Error(), state.ConnectionString.ValueString()),
+		)
+		return
+	}
+}
+
+// ImportState imports the file upload configuration of the application,
+// whatever the import ID.
+func (r *fileUploadResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fileUploadID)...)
+}
+
+// toAPI generates the API request body of the file upload configuration.
+func (m *fileUploadResourceModel) toAPI() fileUpload {
+	return fileUpload{
+		Account:          m.Account.ValueString(),
+		ConnectionString: m.ConnectionString.ValueString(),
+		Container:        m.Container.ValueString(),
+		SasTTL:           m.SasTTL.ValueString(),
+	}
+}
+
+// fromAPI maps a file upload configuration received from the API to the model.
+// The configured connection string is kept, since the API may not return it.
+func (m *fileUploadResourceModel) fromAPI(upload *fileUpload) {
+	m.ID = types.StringValue(fileUploadID)
+	m.Container = types.StringValue(upload.Container)
+	m.Account = optionalStringValue(upload.Account)
+	m.SasTTL = types.StringValue(upload.SasTTL)
+	m.State = types.StringValue(upload.State)
+	m.Etag = types.StringValue(upload.Etag)
+
+	if m.ConnectionString.IsNull() && upload.ConnectionString != "" {
+		m.ConnectionString = types.StringValue(upload.ConnectionString)
+	}
+}
@@ -0,0 +1,80 @@
+package iotcentral
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIotCentralFileUploadResource(t *testing.T) {
+	// File uploads need a real storage account
+	connectionString := os.Getenv("IOTCENTRAL_TEST_STORAGE_CONNECTION_STRING")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if connectionString == "" {
+				t.Skip("IOTCENTRAL_TEST_STORAGE_CONNECTION_STRING must be set for file upload acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "iotcentral_file_upload" "test" {
+					connection_string = %q
+					container = "fileuploads"
+				}
+`, connectionString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify id is set
+					resource.TestCheckResourceAttr("iotcentral_file_upload.test", "id", "fileUpload"),
+					// Verify container is set
+					resource.TestCheckResourceAttr("iotcentral_file_upload.test", "container", "fileuploads"),
+					// Verify provisioning succeeded
+					resource.TestCheckResourceAttr("iotcentral_file_upload.test", "state", "succeeded"),
+					// Verify default sas_ttl is set
+					resource.TestCheckResourceAttr("iotcentral_file_upload.test", "sas_ttl", "PT1H"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "iotcentral_file_upload.test",
+				ImportState:             true,
+				ImportStateId:           "fileUpload",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"connection_string"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "iotcentral_file_upload" "test" {
+					connection_string = %q
+					container = "fileuploads"
+					sas_ttl = "PT2H"
+				}
+`, connectionString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify sas_ttl is updated
+					resource.TestCheckResourceAttr("iotcentral_file_upload.test", "sas_ttl", "PT2H"),
+				),
+			},
+			// Removed settings return to their defaults
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "iotcentral_file_upload" "test" {
+					connection_string = %q
+					container = "fileuploads"
+				}
+`, connectionString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify sas_ttl is back to its default
+					resource.TestCheckResourceAttr("iotcentral_file_upload.test", "sas_ttl", "PT1H"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,378 @@
+package iotcentral
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// maxJobDeviceDiagnostics is the number of failed devices of a job that are
+// reported individually.
+const maxJobDeviceDiagnostics = 10
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &jobResource{}
+	_ resource.ResourceWithConfigure   = &jobResource{}
+	_ resource.ResourceWithImportState = &jobResource{}
+)
+
+// NewJobResource is a helper function to simplify the provider implementation.
+func NewJobResource() resource.Resource {
+	return &jobResource{}
+}
+
+// jobResource is the resource implementation.
+type jobResource struct {
+	client *iotcentral.Client
+}
+
+// jobResourceModel maps job schema data.
+type jobResourceModel struct {
+	ID                    types.String                   `tfsdk:"id"`
+	DisplayName           types.String                   `tfsdk:"display_name"`
+	Description           types.String                   `tfsdk:"description"`
+	Group                 types.String                   `tfsdk:"group"`
+	Batch                 *jobBatchModel                 `tfsdk:"batch"`
+	CancellationThreshold *jobCancellationThresholdModel `tfsdk:"cancellation_threshold"`
+	Data                  []jobDataModel                 `tfsdk:"data"`
+	Organizations         types.List                     `tfsdk:"organizations"`
+	WaitForCompletion     types.Bool                     `tfsdk:"wait_for_completion"`
+	Status                types.String                   `tfsdk:"status"`
+	Timeouts              timeouts.Value                 `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *jobResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job"
+}
+
+// Schema defines the schema for the resource.
+func (r *jobResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := jobAttributes("job", true)
+	attributes["wait_for_completion"] = schema.BoolAttribute{
+		Description: "Wait until the job has run on all devices when it is created. Devices on which the job failed are reported as warnings.",
+		Optional:    true,
+	}
+	attributes["status"] = schema.StringAttribute{
+		Description: "Status of the job, such as `running` or `complete`.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Runs a job that sets properties or calls commands on the devices of a device group. " +
+			"Jobs run once when they are created and cannot be changed, so changing any setting runs a new job. " +
+			"Destroying a job stops it when it is still running, the job remains in the job history of the application.",
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jobResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*iotcentral.Client)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *jobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan jobResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var jobID = plan.ID.ValueString()
+	jobRequest, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create and start new job
+	job, err := createJob(ctx, r.client, jobID, jobRequest)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_job "+jobID, "create", createTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error creating job",
+			"Could not create job, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	diags = plan.fromAPI(ctx, job)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state before waiting, the job runs whether or not the wait succeeds
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	// Wait for the job to run on all devices
+	job, err = waitForJob(ctx, r.client, jobID)
+	if err == nil {
+		err = r.addDeviceFailures(ctx, &resp.Diagnostics, job)
+	}
+
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_job "+jobID, "create", createTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Waiting For IotCentral Job",
+			"Could not wait for completion of IotCentral job ID "+jobID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.Status = types.StringValue(job.Status)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// addDeviceFailures adds a warning for every device on which a finished job
+// failed, and for a job that did not complete.
+func (r *jobResource) addDeviceFailures(ctx context.Context, diags *diag.Diagnostics, job *job) error {
+	devices, err := getJobDevices(ctx, r.client, job.ID)
+	if err != nil {
+		return err
+	}
+
+	var failed []string
+	for _, device := range devices {
+		if device.Status == "failed" {
+			failed = append(failed, device.ID)
+		}
+	}
+
+	for i, deviceID := range failed {
+		if i == maxJobDeviceDiagnostics {
+			diags.AddWarning(
+				"IotCentral Job Failed On Devices",
+				fmt.Sprintf("The job %s failed on %d more devices. Check the job in the IotCentral application for all failed devices.", job.ID, len(failed)-i),
+			)
+			break
+		}
+
+		diags.AddWarning(
+			"IotCentral Job Failed On Device",
+			"The job "+job.ID+" failed on device "+deviceID+".",
+		)
+	}
+
+	if job.Status != "complete" && job.Status != "completed" {
+		diags.AddWarning(
+			"IotCentral Job Did Not Complete",
+			"The job "+job.ID+" finished with status "+job.Status+".",
+		)
+	}
+
+	return nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *jobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state jobResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed job value from IotCentral
+	job, err := getJob(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_job "+state.ID.ValueString(), "read", readTimeout) {
+			return
+		}
+
+		// Remove the resource from state when it was deleted outside Terraform
+		if isNotFound(err) {
+			tflog.Warn(ctx, "IotCentral job not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading IotCentral Job",
+			"Could not read IotCentral job ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	diags = state.fromAPI(ctx, job)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Every job setting requires replacement, so only wait_for_completion and the
+// timeouts are updated in state.
+func (r *jobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan jobResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete stops the job when it is still running and removes the Terraform
+// state on success.
+func (r *jobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state jobResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Stop the job when it has not finished yet
+	job, err := getJob(ctx, r.client, state.ID.ValueString())
+	if err == nil && !jobFinished(job.Status) {
+		err = stopJob(ctx, r.client, state.ID.ValueString())
+	}
+
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_job "+state.ID.ValueString(), "delete", deleteTimeout) {
+			return
+		}
+
+		// Nothing to stop when the job is already gone
+		if isNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting IotCentral Job",
+			"Could not stop job, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *jobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI generates the API request body of the job.
+func (m *jobResourceModel) toAPI(ctx context.Context) (job, diag.Diagnostics) {
+	organizations, diags := jobOrganizationsToAPI(ctx, m.Organizations)
+
+	request := job{
+		jobDefinition: jobDefinition{
+			DisplayName:           m.DisplayName.ValueString(),
+			Description:           m.Description.ValueString(),
+			Group:                 m.Group.ValueString(),
+			Batch:                 jobBatchToAPI(m.Batch),
+			CancellationThreshold: jobCancellationThresholdToAPI(m.CancellationThreshold),
+			Data:                  jobDataToAPI(m.Data),
+			Organizations:         organizations,
+		},
+	}
+
+	return request, diags
+}
+
+// fromAPI maps a job received from the API to the model.
+func (m *jobResourceModel) fromAPI(ctx context.Context, job *job) diag.Diagnostics {
+	m.ID = types.StringValue(job.ID)
+	m.DisplayName = types.StringValue(job.DisplayName)
+	m.Description = optionalStringValue(job.Description)
+	m.Group = types.StringValue(job.Group)
+	m.Batch = jobBatchFromAPI(job.Batch)
+	m.CancellationThreshold = jobCancellationThresholdFromAPI(m.CancellationThreshold, job.CancellationThreshold)
+	m.Data = jobDataFromAPI(m.Data, job.Data)
+	m.Status = types.StringValue(job.Status)
+
+	organizations, diags := jobOrganizationsFromAPI(ctx, m.Organizations, job.Organizations)
+	m.Organizations = organizations
+
+	return diags
+}
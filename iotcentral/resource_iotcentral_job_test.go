@@ -0,0 +1,101 @@
+package iotcentral
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccJobConfig creates a device template with a writable property and a
+// device group of simulated devices to run jobs on.
+const testAccJobConfig = `
+resource "iotcentral_device_template" "test" {
+	id = "dtmi:terraform:test:jobthermostat;1"
+	display_name = "Job thermostat"
+	capability_model = jsonencode({
+		"@id" = "dtmi:terraform:test:jobthermostat:model;1"
+		"@type" = "Interface"
+		"displayName" = "Job thermostat"
+		"contents" = [
+			{
+				"@type" = "Property"
+				"name" = "targetTemperature"
+				"schema" = "double"
+				"writable" = true
+			}
+		]
+	})
+}
+
+resource "iotcentral_device" "test" {
+	id = "testjobdevice"
+	display_name = "Test job device"
+	template = iotcentral_device_template.test.id
+	simulated = true
+}
+
+resource "iotcentral_device_group" "test" {
+	id = "testjobdevicegroup"
+	display_name = "Test job device group"
+	filter = "SELECT * FROM devices WHERE $template = \"dtmi:terraform:test:jobthermostat;1\""
+}
+`
+
+func TestAccIotCentralJobResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccJobConfig + `
+				resource "iotcentral_job" "test" {
+					id = "testjob"
+					display_name = "Set target temperature"
+					group = iotcentral_device_group.test.id
+					wait_for_completion = true
+
+					batch = {
+						type = "percentage"
+						value = 50
+					}
+
+					cancellation_threshold = {
+						type = "number"
+						value = 1
+					}
+
+					data = [
+						{
+							type = "property"
+							target = iotcentral_device_template.test.id
+							path = "targetTemperature"
+							value = jsonencode(21.5)
+						}
+					]
+
+					depends_on = [iotcentral_device.test]
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify id is set
+					resource.TestCheckResourceAttr("iotcentral_job.test", "id", "testjob"),
+					// Verify the job ran to completion
+					resource.TestCheckResourceAttr("iotcentral_job.test", "status", "complete"),
+					// Verify data is set
+					resource.TestCheckResourceAttr("iotcentral_job.test", "data.#", "1"),
+					resource.TestCheckResourceAttr("iotcentral_job.test", "data.0.value", "21.5"),
+					// Verify batch is set
+					resource.TestCheckResourceAttr("iotcentral_job.test", "batch.type", "percentage"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "iotcentral_job.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_completion"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -3,12 +3,14 @@ package iotcentral
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	iotcentral "github.com/kenspur/azure-iot-central-client-go"
 )
 
@@ -31,9 +33,10 @@ type organizationResource struct {
 
 // organizationResourceModel maps organization schema data.
 type organizationResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	DisplayName types.String `tfsdk:"display_name"`
-	Parent      types.String `tfsdk:"parent"`
+	ID          types.String   `tfsdk:"id"`
+	DisplayName types.String   `tfsdk:"display_name"`
+	Parent      types.String   `tfsdk:"parent"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -42,7 +45,7 @@ func (r *organizationResource) Metadata(_ context.Context, req resource.Metadata
 }
 
 // Schema defines the schema for the resource.
-func (r *organizationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *organizationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -67,6 +70,9 @@ func (r *organizationResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -99,9 +105,22 @@ func (r *organizationResource) Create(ctx context.Context, req resource.CreateRe
 		organizationRequest.Parent = plan.Parent.ValueString()
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Create new organization
-	organization, err := r.client.CreateOrganization(organizationID, organizationRequest)
+	organization, err := clientWithContext(ctx, r.client).CreateOrganization(organizationID, organizationRequest)
 	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_organization "+plan.ID.ValueString(), "create", createTimeout) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error creating organization",
 			"Could not create organization, unexpected error: "+err.Error(),
@@ -135,9 +154,29 @@ func (r *organizationResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed organization value from IotCentral
-	organization, err := r.client.GetOrganization(state.ID.ValueString())
+	organization, err := clientWithContext(ctx, r.client).GetOrganization(state.ID.ValueString())
 	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_organization "+state.ID.ValueString(), "read", readTimeout) {
+			return
+		}
+
+		// Remove the resource from state when it was deleted outside Terraform
+		if isNotFound(err) {
+			tflog.Warn(ctx, "IotCentral organization not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Reading IotCentral Organization",
 			"Could not read IotCentral organization ID "+state.ID.ValueString()+": "+err.Error(),
@@ -178,9 +217,22 @@ func (r *organizationResource) Update(ctx context.Context, req resource.UpdateRe
 		Parent:      plan.Parent.ValueString(),
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Update existing organization
-	organization, err := r.client.UpdateOrganization(organizationID, organizationRequest)
+	organization, err := clientWithContext(ctx, r.client).UpdateOrganization(organizationID, organizationRequest)
 	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_organization "+plan.ID.ValueString(), "update", updateTimeout) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Updating IotCentraL Organization",
 			"Could not update organization, unexpected error: "+err.Error(),
@@ -213,9 +265,27 @@ func (r *organizationResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing order
-	err := r.client.DeleteOrganization(state.ID.ValueString())
+	err := clientWithContext(ctx, r.client).DeleteOrganization(state.ID.ValueString())
 	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_organization "+state.ID.ValueString(), "delete", deleteTimeout) {
+			return
+		}
+
+		// Nothing to delete when the resource is already gone
+		if isNotFound(err) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Deleting IotCentral Organization",
 			"Could not delete organization, unexpected error: "+err.Error(),
@@ -0,0 +1,462 @@
+package iotcentral
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &scheduledJobResource{}
+	_ resource.ResourceWithConfigure      = &scheduledJobResource{}
+	_ resource.ResourceWithImportState    = &scheduledJobResource{}
+	_ resource.ResourceWithValidateConfig = &scheduledJobResource{}
+)
+
+// NewScheduledJobResource is a helper function to simplify the provider implementation.
+func NewScheduledJobResource() resource.Resource {
+	return &scheduledJobResource{}
+}
+
+// scheduledJobResource is the resource implementation.
+type scheduledJobResource struct {
+	client *iotcentral.Client
+}
+
+// scheduledJobResourceModel maps scheduled job schema data.
+type scheduledJobResourceModel struct {
+	ID                    types.String                   `tfsdk:"id"`
+	DisplayName           types.String                   `tfsdk:"display_name"`
+	Description           types.String                   `tfsdk:"description"`
+	Group                 types.String                   `tfsdk:"group"`
+	Batch                 *jobBatchModel                 `tfsdk:"batch"`
+	CancellationThreshold *jobCancellationThresholdModel `tfsdk:"cancellation_threshold"`
+	Data                  []jobDataModel                 `tfsdk:"data"`
+	Organizations         types.List                     `tfsdk:"organizations"`
+	Schedule              *jobScheduleModel              `tfsdk:"schedule"`
+	Enabled               types.Bool                     `tfsdk:"enabled"`
+	Completed             types.Bool                     `tfsdk:"completed"`
+	Etag                  types.String                   `tfsdk:"etag"`
+	Timeouts              timeouts.Value                 `tfsdk:"timeouts"`
+}
+
+// jobScheduleModel maps scheduled job schedule schema data.
+type jobScheduleModel struct {
+	Start      types.String         `tfsdk:"start"`
+	Recurrence types.String         `tfsdk:"recurrence"`
+	End        *jobScheduleEndModel `tfsdk:"end"`
+}
+
+// jobScheduleEndModel maps scheduled job schedule end schema data.
+type jobScheduleEndModel struct {
+	Date        types.String `tfsdk:"date"`
+	Occurrences types.Int64  `tfsdk:"occurrences"`
+}
+
+// Metadata returns the resource type name.
+func (r *scheduledJobResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scheduled_job"
+}
+
+// Schema defines the schema for the resource.
+func (r *scheduledJobResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := jobAttributes("scheduled job", false)
+	attributes["schedule"] = schema.SingleNestedAttribute{
+		Description: "When the scheduled job runs.",
+		Required:    true,
+		Attributes: map[string]schema.Attribute{
+			"start": schema.StringAttribute{
+				Description: "RFC 3339 timestamp of the first run, such as `2024-01-01T02:00:00Z`.",
+				Required:    true,
+				Validators: []validator.String{
+					timestampValidator{},
+				},
+			},
+			"recurrence": schema.StringAttribute{
+				Description: "Repeats the job `daily`, `weekly` or `monthly` after the first run. The job runs once when it is not set.",
+				Optional:    true,
+				Validators: []validator.String{
+					oneOfValidator{values: []string{"daily", "weekly", "monthly"}},
+				},
+			},
+			"end": schema.SingleNestedAttribute{
+				Description: "Ends a recurring schedule on a date or after a number of runs. The job repeats indefinitely when it is not set.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"date": schema.StringAttribute{
+						Description: "RFC 3339 timestamp after which the job no longer runs.",
+						Optional:    true,
+						Validators: []validator.String{
+							timestampValidator{},
+						},
+					},
+					"occurrences": schema.Int64Attribute{
+						Description: "Number of runs after which the job no longer runs.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+	attributes["enabled"] = schema.BoolAttribute{
+		Description: "Whether the scheduled job runs. Defaults to `true`.",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(true),
+	}
+	attributes["completed"] = schema.BoolAttribute{
+		Description: "Whether the schedule has ended and the job no longer runs.",
+		Computed:    true,
+	}
+	attributes["etag"] = schema.StringAttribute{
+		Description: "ETag of the scheduled job, changed on every update.",
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a scheduled job that sets properties or calls commands on the devices of a device group " +
+			"once or on a recurring schedule.",
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// ValidateConfig validates the end of the schedule.
+func (r *scheduledJobResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg scheduledJobResourceModel
+	diags := req.Config.Get(ctx, &cfg)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if cfg.Schedule == nil || cfg.Schedule.End == nil {
+		return
+	}
+
+	end := path.Root("schedule").AtName("end")
+	if cfg.Schedule.End.Date.IsNull() == cfg.Schedule.End.Occurrences.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			end,
+			"Invalid Schedule Configuration",
+			"Exactly one of date or occurrences must be set in "+end.String()+".",
+		)
+	}
+
+	validateRequired(&resp.Diagnostics, path.Root("schedule").AtName("recurrence"), cfg.Schedule.Recurrence, "with end")
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *scheduledJobResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*iotcentral.Client)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *scheduledJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan scheduledJobResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var jobID = plan.ID.ValueString()
+	jobRequest, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create new scheduled job
+	job, err := createScheduledJob(ctx, r.client, jobID, jobRequest)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_scheduled_job "+jobID, "create", createTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error creating scheduled job",
+			"Could not create scheduled job, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	diags = plan.fromAPI(ctx, job)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *scheduledJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state scheduledJobResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed scheduled job value from IotCentral
+	job, err := getScheduledJob(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_scheduled_job "+state.ID.ValueString(), "read", readTimeout) {
+			return
+		}
+
+		// Remove the resource from state when it was deleted outside Terraform
+		if isNotFound(err) {
+			tflog.Warn(ctx, "IotCentral scheduled job not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading IotCentral Scheduled Job",
+			"Could not read IotCentral scheduled job ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	diags = state.fromAPI(ctx, job)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *scheduledJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan scheduledJobResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var jobID = plan.ID.ValueString()
+	jobRequest, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing scheduled job
+	job, err := updateScheduledJob(ctx, r.client, jobID, jobRequest)
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_scheduled_job "+jobID, "update", updateTimeout) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Updating IotCentral Scheduled Job",
+			"Could not update scheduled job, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated items
+	diags = plan.fromAPI(ctx, job)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *scheduledJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state scheduledJobResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing scheduled job
+	err := deleteScheduledJob(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_scheduled_job "+state.ID.ValueString(), "delete", deleteTimeout) {
+			return
+		}
+
+		// Nothing to delete when the resource is already gone
+		if isNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting IotCentral Scheduled Job",
+			"Could not delete scheduled job, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *scheduledJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI generates the API request body of the scheduled job.
+func (m *scheduledJobResourceModel) toAPI(ctx context.Context) (scheduledJob, diag.Diagnostics) {
+	organizations, diags := jobOrganizationsToAPI(ctx, m.Organizations)
+
+	request := scheduledJob{
+		jobDefinition: jobDefinition{
+			DisplayName:           m.DisplayName.ValueString(),
+			Description:           m.Description.ValueString(),
+			Group:                 m.Group.ValueString(),
+			Batch:                 jobBatchToAPI(m.Batch),
+			CancellationThreshold: jobCancellationThresholdToAPI(m.CancellationThreshold),
+			Data:                  jobDataToAPI(m.Data),
+			Organizations:         organizations,
+		},
+		Enabled: m.Enabled.ValueBool(),
+	}
+
+	if m.Schedule != nil {
+		request.Schedule = jobSchedule{
+			Start:      m.Schedule.Start.ValueString(),
+			Recurrence: m.Schedule.Recurrence.ValueString(),
+		}
+
+		if end := m.Schedule.End; end != nil {
+			request.Schedule.End = &jobScheduleEnd{Type: "date", Date: end.Date.ValueString()}
+			if !end.Occurrences.IsNull() {
+				request.Schedule.End = &jobScheduleEnd{Type: "occurrences", Occurrences: end.Occurrences.ValueInt64()}
+			}
+		}
+	}
+
+	return request, diags
+}
+
+// fromAPI maps a scheduled job received from the API to the model.
+func (m *scheduledJobResourceModel) fromAPI(ctx context.Context, job *scheduledJob) diag.Diagnostics {
+	m.ID = types.StringValue(job.ID)
+	m.DisplayName = types.StringValue(job.DisplayName)
+	m.Description = optionalStringValue(job.Description)
+	m.Group = types.StringValue(job.Group)
+	m.Batch = jobBatchFromAPI(job.Batch)
+	m.CancellationThreshold = jobCancellationThresholdFromAPI(m.CancellationThreshold, job.CancellationThreshold)
+	m.Data = jobDataFromAPI(m.Data, job.Data)
+	m.Enabled = types.BoolValue(job.Enabled)
+	m.Completed = types.BoolValue(job.Completed)
+	m.Etag = types.StringValue(job.Etag)
+
+	// Keep the configured timestamps when the API formats them differently
+	prior := m.Schedule
+	if prior == nil {
+		prior = &jobScheduleModel{Start: types.StringNull()}
+	}
+
+	schedule := &jobScheduleModel{
+		Start:      timestampStateValue(prior.Start, job.Schedule.Start),
+		Recurrence: optionalStringValue(job.Schedule.Recurrence),
+	}
+
+	if end := job.Schedule.End; end != nil {
+		schedule.End = &jobScheduleEndModel{
+			Date:        types.StringNull(),
+			Occurrences: types.Int64Null(),
+		}
+
+		if end.Type == "occurrences" {
+			schedule.End.Occurrences = types.Int64Value(end.Occurrences)
+		} else {
+			priorDate := types.StringNull()
+			if prior.End != nil {
+				priorDate = prior.End.Date
+			}
+
+			schedule.End.Date = timestampStateValue(priorDate, end.Date)
+		}
+	}
+
+	m.Schedule = schedule
+
+	organizations, diags := jobOrganizationsFromAPI(ctx, m.Organizations, job.Organizations)
+	m.Organizations = organizations
+
+	return diags
+}
@@ -0,0 +1,168 @@
+package iotcentral
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIotCentralScheduledJobResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Schedule validation testing
+			{
+				Config: providerConfig + testAccJobConfig + `
+				resource "iotcentral_scheduled_job" "test" {
+					id = "testscheduledjob"
+					display_name = "Nightly target temperature"
+					group = iotcentral_device_group.test.id
+
+					schedule = {
+						start = "2030-01-01T02:00:00Z"
+						recurrence = "daily"
+						end = {
+							date = "2030-02-01T02:00:00Z"
+							occurrences = 10
+						}
+					}
+
+					data = [
+						{
+							type = "property"
+							target = iotcentral_device_template.test.id
+							path = "targetTemperature"
+							value = jsonencode(21.5)
+						}
+					]
+				}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Schedule Configuration`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccJobConfig + `
+				resource "iotcentral_scheduled_job" "test" {
+					id = "testscheduledjob"
+					display_name = "Nightly target temperature"
+					group = iotcentral_device_group.test.id
+
+					schedule = {
+						start = "2030-01-01T02:00:00Z"
+						recurrence = "daily"
+						end = {
+							occurrences = 10
+						}
+					}
+
+					data = [
+						{
+							type = "property"
+							target = iotcentral_device_template.test.id
+							path = "targetTemperature"
+							value = jsonencode(21.5)
+						}
+					]
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify id is set
+					resource.TestCheckResourceAttr("iotcentral_scheduled_job.test", "id", "testscheduledjob"),
+					// Verify schedule is set
+					resource.TestCheckResourceAttr("iotcentral_scheduled_job.test", "schedule.recurrence", "daily"),
+					resource.TestCheckResourceAttr("iotcentral_scheduled_job.test", "schedule.end.occurrences", "10"),
+					// Verify default enabled is set
+					resource.TestCheckResourceAttr("iotcentral_scheduled_job.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("iotcentral_scheduled_job.test", "completed", "false"),
+					// Verify etag is set
+					resource.TestCheckResourceAttrSet("iotcentral_scheduled_job.test", "etag"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "iotcentral_scheduled_job.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccJobConfig + `
+				resource "iotcentral_scheduled_job" "test" {
+					id = "testscheduledjob"
+					display_name = "Weekly target temperature"
+					group = iotcentral_device_group.test.id
+					enabled = false
+
+					schedule = {
+						start = "2030-01-01T02:00:00Z"
+						recurrence = "weekly"
+						end = {
+							date = "2030-06-01T02:00:00Z"
+						}
+					}
+
+					cancellation_threshold = {
+						type = "percentage"
+						value = 10
+						batch = true
+					}
+
+					data = [
+						{
+							type = "property"
+							target = iotcentral_device_template.test.id
+							path = "targetTemperature"
+							value = jsonencode(19)
+						}
+					]
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify display_name is updated
+					resource.TestCheckResourceAttr("iotcentral_scheduled_job.test", "display_name", "Weekly target temperature"),
+					// Verify schedule is updated
+					resource.TestCheckResourceAttr("iotcentral_scheduled_job.test", "schedule.recurrence", "weekly"),
+					resource.TestCheckResourceAttr("iotcentral_scheduled_job.test", "schedule.end.date", "2030-06-01T02:00:00Z"),
+					// Verify enabled is updated
+					resource.TestCheckResourceAttr("iotcentral_scheduled_job.test", "enabled", "false"),
+					// Verify cancellation_threshold is set
+					resource.TestCheckResourceAttr("iotcentral_scheduled_job.test", "cancellation_threshold.batch", "true"),
+				),
+			},
+			// Removed settings are cleared
+			{
+				Config: providerConfig + testAccJobConfig + `
+				resource "iotcentral_scheduled_job" "test" {
+					id = "testscheduledjob"
+					display_name = "Weekly target temperature"
+					group = iotcentral_device_group.test.id
+
+					schedule = {
+						start = "2030-01-01T02:00:00Z"
+						recurrence = "weekly"
+					}
+
+					data = [
+						{
+							type = "property"
+							target = iotcentral_device_template.test.id
+							path = "targetTemperature"
+							value = jsonencode(19)
+						}
+					]
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify schedule end is removed
+					resource.TestCheckNoResourceAttr("iotcentral_scheduled_job.test", "schedule.end"),
+					// Verify cancellation_threshold is removed
+					resource.TestCheckNoResourceAttr("iotcentral_scheduled_job.test", "cancellation_threshold"),
+					// Verify enabled is back to its default
+					resource.TestCheckResourceAttr("iotcentral_scheduled_job.test", "enabled", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -3,12 +3,14 @@ package iotcentral
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	iotcentral "github.com/kenspur/azure-iot-central-client-go"
 )
 
@@ -35,6 +37,7 @@ type servicePrincipalUserResourceModel struct {
 	ObjectID types.String                  `tfsdk:"object_id"`
 	TenantID types.String                  `tfsdk:"tenant_id"`
 	Roles    []roleAssignmentResourceModel `tfsdk:"roles"`
+	Timeouts timeouts.Value                `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -43,7 +46,7 @@ func (r *servicePrincipalUserResource) Metadata(_ context.Context, req resource.
 }
 
 // Schema defines the schema for the resource.
-func (r *servicePrincipalUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *servicePrincipalUserResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -81,6 +84,9 @@ func (r *servicePrincipalUserResource) Schema(_ context.Context, _ resource.Sche
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -121,9 +127,22 @@ func (r *servicePrincipalUserResource) Create(ctx context.Context, req resource.
 		servicePrincipalUserRequest.Roles = append(servicePrincipalUserRequest.Roles, roleToAdd)
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Create new service principal user
-	servicePrincipalUser, err := r.client.CreateServicePrincipalUser(servicePrincipalUserRequest)
+	servicePrincipalUser, err := clientWithContext(ctx, r.client).CreateServicePrincipalUser(servicePrincipalUserRequest)
 	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_service_principal_user "+plan.ObjectID.ValueString(), "create", createTimeout) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error creating service principal user",
 			"Could not create service principal user, unexpected error: "+err.Error(),
@@ -167,9 +186,29 @@ func (r *servicePrincipalUserResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed service principal user value from IotCentral
-	servicePrincipalUser, err := r.client.GetServicePrincipalUser(state.ID.ValueString())
+	servicePrincipalUser, err := clientWithContext(ctx, r.client).GetServicePrincipalUser(state.ID.ValueString())
 	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_service_principal_user "+state.ID.ValueString(), "read", readTimeout) {
+			return
+		}
+
+		// Remove the resource from state when it was deleted outside Terraform
+		if isNotFound(err) {
+			tflog.Warn(ctx, "IotCentral service principal user not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Reading IotCentral User",
 			"Could not read IotCentral service principal user ID "+state.ID.ValueString()+": "+err.Error(),
@@ -238,9 +277,22 @@ func (r *servicePrincipalUserResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Update existing service principal user
-	servicePrincipalUser, err := r.client.UpdateServicePrincipalUser(state.ID.ValueString(), servicePrincipalUserRequest)
+	servicePrincipalUser, err := clientWithContext(ctx, r.client).UpdateServicePrincipalUser(state.ID.ValueString(), servicePrincipalUserRequest)
 	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_service_principal_user "+state.ID.ValueString(), "update", updateTimeout) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Updating IotCentraL User",
 			"Could not update service principal user, unexpected error: "+err.Error(),
@@ -283,9 +335,27 @@ func (r *servicePrincipalUserResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing order
-	err := r.client.DeleteUser(state.ID.ValueString())
+	err := clientWithContext(ctx, r.client).DeleteUser(state.ID.ValueString())
 	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_service_principal_user "+state.ID.ValueString(), "delete", deleteTimeout) {
+			return
+		}
+
+		// Nothing to delete when the resource is already gone
+		if isNotFound(err) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Deleting IotCentral User",
 			"Could not delete service principal user, unexpected error: "+err.Error(),
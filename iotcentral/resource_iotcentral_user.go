@@ -3,12 +3,14 @@ package iotcentral
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	iotcentral "github.com/kenspur/azure-iot-central-client-go"
 )
 
@@ -31,9 +33,10 @@ type userResource struct {
 
 // userResourceModel maps user schema data.
 type userResourceModel struct {
-	ID    types.String                  `tfsdk:"id"`
-	Email types.String                  `tfsdk:"email"`
-	Roles []roleAssignmentResourceModel `tfsdk:"roles"`
+	ID       types.String                  `tfsdk:"id"`
+	Email    types.String                  `tfsdk:"email"`
+	Roles    []roleAssignmentResourceModel `tfsdk:"roles"`
+	Timeouts timeouts.Value                `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -42,7 +45,7 @@ func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest,
 }
 
 // Schema defines the schema for the resource.
-func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *userResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -73,6 +76,9 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -112,9 +118,22 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		userRequest.Roles = append(userRequest.Roles, roleToAdd)
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Create new user
-	user, err := r.client.CreateUser(userRequest)
+	user, err := clientWithContext(ctx, r.client).CreateUser(userRequest)
 	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_user "+plan.Email.ValueString(), "create", createTimeout) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error creating user",
 			"Could not create user, unexpected error: "+err.Error(),
@@ -157,9 +176,29 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed user value from IotCentral
-	user, err := r.client.GetUser(state.ID.ValueString())
+	user, err := clientWithContext(ctx, r.client).GetUser(state.ID.ValueString())
 	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_user "+state.ID.ValueString(), "read", readTimeout) {
+			return
+		}
+
+		// Remove the resource from state when it was deleted outside Terraform
+		if isNotFound(err) {
+			tflog.Warn(ctx, "IotCentral user not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Reading IotCentral User",
 			"Could not read IotCentral user ID "+state.ID.ValueString()+": "+err.Error(),
@@ -226,9 +265,22 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Update existing user
-	user, err := r.client.UpdateUser(state.ID.ValueString(), userRequest)
+	user, err := clientWithContext(ctx, r.client).UpdateUser(state.ID.ValueString(), userRequest)
 	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_user "+state.ID.ValueString(), "update", updateTimeout) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Updating IotCentraL User",
 			"Could not update user, unexpected error: "+err.Error(),
@@ -270,9 +322,27 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing order
-	err := r.client.DeleteUser(state.ID.ValueString())
+	err := clientWithContext(ctx, r.client).DeleteUser(state.ID.ValueString())
 	if err != nil {
+		if addTimeoutError(ctx, &resp.Diagnostics, "iotcentral_user "+state.ID.ValueString(), "delete", deleteTimeout) {
+			return
+		}
+
+		// Nothing to delete when the resource is already gone
+		if isNotFound(err) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Deleting IotCentral User",
 			"Could not delete user, unexpected error: "+err.Error(),
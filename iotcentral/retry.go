@@ -0,0 +1,91 @@
+package iotcentral
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Default values of the retry provider attributes.
+const (
+	defaultMaxRetries    = 3
+	defaultRetryMinDelay = 1 * time.Second
+	defaultRetryMaxDelay = 30 * time.Second
+)
+
+// retryPolicy controls how throttled and transient API failures are retried.
+type retryPolicy struct {
+	MaxRetries int
+	MinDelay   time.Duration
+	MaxDelay   time.Duration
+}
+
+// shouldRetry reports whether a response is a throttling or transient error
+// worth another attempt. Throttled requests were not processed and are always
+// retried. Server errors may occur after the request took effect, so they are
+// only retried for methods that are safe to repeat.
+func (p retryPolicy) shouldRetry(method string, res *http.Response) bool {
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return isIdempotent(method)
+	default:
+		return false
+	}
+}
+
+// delay returns how long to wait before the next attempt. A Retry-After
+// header takes precedence over the exponential backoff. Both are capped at
+// the maximum delay.
+func (p retryPolicy) delay(attempt int, res *http.Response) time.Duration {
+	if retryAfter, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+		if retryAfter > p.MaxDelay {
+			return p.MaxDelay
+		}
+
+		return retryAfter
+	}
+
+	delay := p.MinDelay
+	for i := 1; i < attempt && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	// Add up to 20% jitter so parallel requests do not retry in lockstep
+	if delay > 0 {
+		delay += time.Duration(rand.Int63n(int64(delay)/5 + 1))
+	}
+
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	return delay
+}
+
+// parseRetryAfter parses a Retry-After header in seconds or HTTP date form.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+
+		return delay, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,102 @@
+package iotcentral
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyShouldRetry(t *testing.T) {
+	tests := []struct {
+		method string
+		status int
+		want   bool
+	}{
+		{method: http.MethodGet, status: http.StatusOK, want: false},
+		{method: http.MethodGet, status: http.StatusBadRequest, want: false},
+		{method: http.MethodGet, status: http.StatusNotFound, want: false},
+		{method: http.MethodGet, status: http.StatusConflict, want: false},
+		{method: http.MethodGet, status: http.StatusTooManyRequests, want: true},
+		{method: http.MethodGet, status: http.StatusInternalServerError, want: true},
+		{method: http.MethodGet, status: http.StatusBadGateway, want: true},
+		{method: http.MethodGet, status: http.StatusServiceUnavailable, want: true},
+		{method: http.MethodGet, status: http.StatusGatewayTimeout, want: true},
+		{method: http.MethodPut, status: http.StatusServiceUnavailable, want: true},
+		{method: http.MethodDelete, status: http.StatusInternalServerError, want: true},
+		{method: http.MethodPost, status: http.StatusTooManyRequests, want: true},
+		{method: http.MethodPost, status: http.StatusServiceUnavailable, want: false},
+		{method: http.MethodPatch, status: http.StatusInternalServerError, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+http.StatusText(tt.status), func(t *testing.T) {
+			if got := (retryPolicy{}).shouldRetry(tt.method, &http.Response{StatusCode: tt.status}); got != tt.want {
+				t.Errorf("shouldRetry(%s, %d) = %v, want %v", tt.method, tt.status, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := retryPolicy{MaxRetries: 5, MinDelay: time.Second, MaxDelay: 10 * time.Second}
+
+	tests := []struct {
+		name       string
+		attempt    int
+		retryAfter string
+		wantMin    time.Duration
+		wantMax    time.Duration
+	}{
+		{name: "first attempt", attempt: 1, wantMin: time.Second, wantMax: 1200 * time.Millisecond},
+		{name: "second attempt", attempt: 2, wantMin: 2 * time.Second, wantMax: 2400 * time.Millisecond},
+		{name: "third attempt", attempt: 3, wantMin: 4 * time.Second, wantMax: 4800 * time.Millisecond},
+		{name: "capped backoff", attempt: 5, wantMin: 10 * time.Second, wantMax: 10 * time.Second},
+		{name: "capped jitter", attempt: 4, wantMin: 8 * time.Second, wantMax: 9600 * time.Millisecond},
+		{name: "retry after seconds", attempt: 1, retryAfter: "3", wantMin: 3 * time.Second, wantMax: 3 * time.Second},
+		{name: "retry after zero", attempt: 3, retryAfter: "0", wantMin: 0, wantMax: 0},
+		{name: "retry after capped", attempt: 1, retryAfter: "60", wantMin: 10 * time.Second, wantMax: 10 * time.Second},
+		{name: "retry after date", attempt: 1, retryAfter: time.Now().Add(6 * time.Second).UTC().Format(http.TimeFormat), wantMin: 4 * time.Second, wantMax: 6 * time.Second},
+		{name: "retry after date capped", attempt: 1, retryAfter: time.Now().Add(time.Hour).UTC().Format(http.TimeFormat), wantMin: 10 * time.Second, wantMax: 10 * time.Second},
+		{name: "retry after date passed", attempt: 1, retryAfter: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), wantMin: 0, wantMax: 0},
+		{name: "retry after invalid", attempt: 2, retryAfter: "soon", wantMin: 2 * time.Second, wantMax: 2400 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+			if tt.retryAfter != "" {
+				res.Header.Set("Retry-After", tt.retryAfter)
+			}
+
+			// Jitter is random, so check the bounds over many samples
+			for i := 0; i < 100; i++ {
+				if got := policy.delay(tt.attempt, res); got < tt.wantMin || got > tt.wantMax {
+					t.Fatalf("delay(%d) = %s, want between %s and %s", tt.attempt, got, tt.wantMin, tt.wantMax)
+				}
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{value: "", wantOK: false},
+		{value: "5", want: 5 * time.Second, wantOK: true},
+		{value: "-5", wantOK: false},
+		{value: "1.5", wantOK: false},
+		{value: "Wed, 21 Oct 2015 07:28:00 GMT", want: 0, wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, ok := parseRetryAfter(tt.value)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("parseRetryAfter(%q) = %s, %v, want %s, %v", tt.value, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
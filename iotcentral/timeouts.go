@@ -0,0 +1,96 @@
+package iotcentral
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	iotcentral "github.com/kenspur/azure-iot-central-client-go"
+)
+
+// Default timeouts of the resource operations.
+const (
+	defaultCreateTimeout = 20 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 20 * time.Minute
+	defaultDeleteTimeout = 20 * time.Minute
+)
+
+// timeoutsBlock returns the schema of the timeouts block shared by all resources.
+func timeoutsBlock(ctx context.Context) schema.Block {
+	description := func(operation string, defaultTimeout time.Duration) string {
+		return fmt.Sprintf("Timeout of the %s operation as a duration such as `30s` or `10m`. Defaults to `%s`.", operation, formatDuration(defaultTimeout))
+	}
+
+	// timeouts.Block has no option for the description of the block itself
+	block := timeouts.Block(ctx, timeouts.Opts{
+		Create:            true,
+		Read:              true,
+		Update:            true,
+		Delete:            true,
+		CreateDescription: description("create", defaultCreateTimeout),
+		ReadDescription:   description("read", defaultReadTimeout),
+		UpdateDescription: description("update", defaultUpdateTimeout),
+		DeleteDescription: description("delete", defaultDeleteTimeout),
+	}).(schema.SingleNestedBlock)
+
+	block.Description = "Timeouts of the resource operations."
+
+	return block
+}
+
+// formatDuration formats a duration without trailing zero seconds, such as 20m.
+func formatDuration(d time.Duration) string {
+	if s := d.String(); strings.HasSuffix(s, "m0s") {
+		return strings.TrimSuffix(s, "0s")
+	}
+
+	return d.String()
+}
+
+// addTimeoutError adds a diagnostic naming the resource and operation when
+// the operation context expired, and reports whether it did.
+func addTimeoutError(ctx context.Context, diags *diag.Diagnostics, resourceName, operation string, timeout time.Duration) bool {
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return false
+	}
+
+	diags.AddError(
+		"IotCentral Operation Timed Out",
+		fmt.Sprintf("The %s operation on %s did not complete within %s. "+
+			"Increase the %s value of the timeouts block if the IotCentral API needs more time.", operation, resourceName, timeout, operation),
+	)
+
+	return true
+}
+
+// clientWithContext returns a copy of the client that sends its requests with
+// ctx, so cancellation and deadlines reach the HTTP requests.
+func clientWithContext(ctx context.Context, client *iotcentral.Client) *iotcentral.Client {
+	c := *client
+	c.HTTPClient = &http.Client{
+		Transport: &contextTransport{
+			ctx:  ctx,
+			base: client.HTTPClient.Transport,
+		},
+	}
+
+	return &c
+}
+
+// contextTransport replaces the context of outgoing requests.
+type contextTransport struct {
+	ctx  context.Context
+	base http.RoundTripper
+}
+
+// RoundTrip sends the request with the transport context.
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.base.RoundTrip(req.WithContext(t.ctx))
+}
@@ -0,0 +1,64 @@
+package iotcentral
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// timestampEqual reports whether two RFC 3339 timestamps denote the same
+// instant, ignoring differences in precision and time zone notation.
+func timestampEqual(a, b string) bool {
+	at, err := time.Parse(time.RFC3339, a)
+	if err != nil {
+		return false
+	}
+
+	bt, err := time.Parse(time.RFC3339, b)
+	if err != nil {
+		return false
+	}
+
+	return at.Equal(bt)
+}
+
+// timestampStateValue returns the timestamp received from the API as a state
+// value, keeping the prior value when it denotes the same instant so
+// formatting differences do not show up as drift.
+func timestampStateValue(prior types.String, received string) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() && timestampEqual(prior.ValueString(), received) {
+		return prior
+	}
+
+	return types.StringValue(received)
+}
+
+// timestampValidator validates that a string is an RFC 3339 timestamp.
+type timestampValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v timestampValidator) Description(_ context.Context) string {
+	return "value must be an RFC 3339 timestamp such as `2030-01-01T00:00:00Z`"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v timestampValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v timestampValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timestamp",
+			"Attribute "+req.Path.String()+" "+v.Description(ctx)+": "+err.Error(),
+		)
+	}
+}
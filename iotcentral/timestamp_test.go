@@ -0,0 +1,51 @@
+package iotcentral
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTimestampEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want bool
+	}{
+		{name: "identical", a: "2030-01-01T00:00:00Z", b: "2030-01-01T00:00:00Z", want: true},
+		{name: "precision", a: "2030-01-01T00:00:00Z", b: "2030-01-01T00:00:00.000Z", want: true},
+		{name: "time zone", a: "2030-01-01T01:00:00+01:00", b: "2030-01-01T00:00:00Z", want: true},
+		{name: "different instant", a: "2030-01-01T00:00:00Z", b: "2030-01-01T00:00:01Z", want: false},
+		{name: "invalid", a: "2030-01-01", b: "2030-01-01T00:00:00Z", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := timestampEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("timestampEqual(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimestampStateValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		prior    types.String
+		received string
+		want     types.String
+	}{
+		{name: "same instant keeps prior", prior: types.StringValue("2030-01-01T00:00:00Z"), received: "2030-01-01T00:00:00.000Z", want: types.StringValue("2030-01-01T00:00:00Z")},
+		{name: "different instant", prior: types.StringValue("2030-01-01T00:00:00Z"), received: "2031-01-01T00:00:00Z", want: types.StringValue("2031-01-01T00:00:00Z")},
+		{name: "null prior", prior: types.StringNull(), received: "2030-01-01T00:00:00Z", want: types.StringValue("2030-01-01T00:00:00Z")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := timestampStateValue(tt.prior, tt.received); !got.Equal(tt.want) {
+				t.Errorf("timestampStateValue() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
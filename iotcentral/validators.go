@@ -0,0 +1,71 @@
+package iotcentral
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// oneOfValidator validates that a string is one of the allowed values.
+type oneOfValidator struct {
+	values []string
+}
+
+// Description describes the validation in plain text formatting.
+func (v oneOfValidator) Description(_ context.Context) string {
+	return "value must be one of: " + strings.Join(v.values, ", ")
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v oneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, value := range v.values {
+		if req.ConfigValue.ValueString() == value {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		"Attribute "+req.Path.String()+" "+v.Description(ctx)+", got: "+req.ConfigValue.ValueString(),
+	)
+}
+
+// validateExactlyOneAttribute adds an error unless exactly one of the
+// top-level attributes is configured. Unknown values count as configured.
+func validateExactlyOneAttribute(diags *diag.Diagnostics, attributes map[string]types.String) {
+	var names, set []string
+	for name, value := range attributes {
+		names = append(names, name)
+		if !value.IsNull() {
+			set = append(set, name)
+		}
+	}
+
+	if len(set) == 1 {
+		return
+	}
+
+	sort.Strings(names)
+	sort.Strings(set)
+
+	detail := "Exactly one of " + strings.Join(names, " or ") + " must be configured"
+	if len(set) > 1 {
+		detail += ", got: " + strings.Join(set, ", ")
+	}
+
+	diags.AddError("Invalid Attribute Combination", detail+".")
+}
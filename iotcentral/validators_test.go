@@ -0,0 +1,70 @@
+package iotcentral
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOneOfValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{name: "allowed", value: types.StringValue("telemetry")},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+		{name: "case mismatch", value: types.StringValue("Telemetry"), wantErr: true},
+		{name: "not allowed", value: types.StringValue("logs"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("source"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+
+			oneOfValidator{values: dataExportSources}.ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("ValidateString(%s) errors = %v, wantErr %v", tt.value, resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateExactlyOneAttribute(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         types.String
+		email      types.String
+		wantDetail string
+	}{
+		{name: "one", id: types.StringValue("user1"), email: types.StringNull()},
+		{name: "unknown", id: types.StringNull(), email: types.StringUnknown()},
+		{name: "none", id: types.StringNull(), email: types.StringNull(), wantDetail: "Exactly one of email or id must be configured."},
+		{name: "both", id: types.StringValue("user1"), email: types.StringUnknown(), wantDetail: "Exactly one of email or id must be configured, got: email, id."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateExactlyOneAttribute(&diags, map[string]types.String{"id": tt.id, "email": tt.email})
+
+			if tt.wantDetail == "" {
+				if diags.HasError() {
+					t.Errorf("validateExactlyOneAttribute() errors = %v, want none", diags)
+				}
+				return
+			}
+
+			if len(diags) != 1 || diags[0].Detail() != tt.wantDetail {
+				t.Errorf("validateExactlyOneAttribute() errors = %v, want %q", diags, tt.wantDetail)
+			}
+		})
+	}
+}